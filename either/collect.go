@@ -0,0 +1,69 @@
+package either
+
+import "github.com/sjpeterson/typical/tuples"
+
+// Collect returns Right with the values of a slice of Eithers if all of them
+// are Right, and Left with the values of all the Lefts otherwise. Unlike
+// stopping at the first Left, this reports every problem at once, e.g. when
+// validating input.
+func Collect[E, T any](xs []Either[E, T]) Either[[]E, []T] {
+	lefts, rights := Partition(xs)
+	if len(lefts) > 0 {
+		return Left[[]E, []T](lefts)
+	}
+
+	return Right[[]E](rights)
+}
+
+// Collect2 returns Right with a Pair of the values of two Eithers if both are
+// Right, and Left with the values of all the Lefts otherwise.
+func Collect2[E, A, B any](a Either[E, A], b Either[E, B]) Either[[]E, tuples.Pair[A, B]] {
+	var lefts []E
+	first := collectInto(a, &lefts)
+	second := collectInto(b, &lefts)
+	if len(lefts) > 0 {
+		return Left[[]E, tuples.Pair[A, B]](lefts)
+	}
+
+	return Right[[]E](tuples.NewPair(first, second))
+}
+
+// Collect3 returns Right with a Tup3 of the values of three Eithers if all are
+// Right, and Left with the values of all the Lefts otherwise.
+func Collect3[E, A, B, C any](a Either[E, A], b Either[E, B], c Either[E, C]) Either[[]E, tuples.Tup3[A, B, C]] {
+	var lefts []E
+	first := collectInto(a, &lefts)
+	second := collectInto(b, &lefts)
+	third := collectInto(c, &lefts)
+	if len(lefts) > 0 {
+		return Left[[]E, tuples.Tup3[A, B, C]](lefts)
+	}
+
+	return Right[[]E](tuples.NewTup3(first, second, third))
+}
+
+// Collect4 returns Right with a Tup4 of the values of four Eithers if all are
+// Right, and Left with the values of all the Lefts otherwise.
+func Collect4[E, A, B, C, D any](a Either[E, A], b Either[E, B], c Either[E, C], d Either[E, D]) Either[[]E, tuples.Tup4[A, B, C, D]] {
+	var lefts []E
+	first := collectInto(a, &lefts)
+	second := collectInto(b, &lefts)
+	third := collectInto(c, &lefts)
+	fourth := collectInto(d, &lefts)
+	if len(lefts) > 0 {
+		return Left[[]E, tuples.Tup4[A, B, C, D]](lefts)
+	}
+
+	return Right[[]E](tuples.NewTup4(first, second, third, fourth))
+}
+
+// collectInto returns the right value of an Either, or appends its left value
+// to lefts.
+func collectInto[E, T any](e Either[E, T], lefts *[]E) T {
+	if left, ok := e.UnwrapLeft(); ok {
+		*lefts = append(*lefts, left)
+	}
+	right, _ := e.UnwrapRight()
+
+	return right
+}
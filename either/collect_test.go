@@ -0,0 +1,92 @@
+package either
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sjpeterson/typical/tuples"
+)
+
+func validateName(name string) Either[string, string] {
+	if name == "" {
+		return Left[string, string]("name is required")
+	}
+
+	return Right[string](name)
+}
+
+func validateAge(age int) Either[string, int] {
+	if age < 0 {
+		return Left[string, int]("age must not be negative")
+	}
+
+	return Right[string](age)
+}
+
+func validateEmail(email string) Either[string, string] {
+	if email == "" {
+		return Left[string, string]("email is required")
+	}
+
+	return Right[string](email)
+}
+
+func TestCollect(t *testing.T) {
+	valid := Collect([]Either[string, int]{validateAge(1), validateAge(2)})
+	if rights, ok := valid.UnwrapRight(); !ok || !reflect.DeepEqual(rights, []int{1, 2}) {
+		t.Errorf("expected Right([1 2]), but got %v, %t", rights, ok)
+	}
+
+	invalid := Collect([]Either[string, int]{validateAge(-1), validateAge(2), validateAge(-3)})
+	expected := []string{"age must not be negative", "age must not be negative"}
+	if lefts, ok := invalid.UnwrapLeft(); !ok || !reflect.DeepEqual(lefts, expected) {
+		t.Errorf("expected Left(%v), but got %v, %t", expected, lefts, ok)
+	}
+
+	if rights, ok := Collect[string, int](nil).UnwrapRight(); !ok || len(rights) != 0 {
+		t.Errorf("expected Collect of no Eithers to be Right([]), but got %v, %t", rights, ok)
+	}
+}
+
+func TestCollect2(t *testing.T) {
+	valid := Collect2(validateName("Alice"), validateAge(31))
+	if pair, ok := valid.UnwrapRight(); !ok || pair != tuples.NewPair("Alice", 31) {
+		t.Errorf("expected Right({Alice 31}), but got %v, %t", pair, ok)
+	}
+
+	invalid := Collect2(validateName(""), validateAge(-1))
+	expected := []string{"name is required", "age must not be negative"}
+	if lefts, ok := invalid.UnwrapLeft(); !ok || !reflect.DeepEqual(lefts, expected) {
+		t.Errorf("expected Left(%v), but got %v, %t", expected, lefts, ok)
+	}
+}
+
+func TestCollect3(t *testing.T) {
+	valid := Collect3(validateName("Alice"), validateAge(31), validateEmail("alice@example.com"))
+	if tup, ok := valid.UnwrapRight(); !ok || tup != tuples.NewTup3("Alice", 31, "alice@example.com") {
+		t.Errorf("expected Right({Alice 31 alice@example.com}), but got %v, %t", tup, ok)
+	}
+
+	invalid := Collect3(validateName("Alice"), validateAge(-1), validateEmail(""))
+	expected := []string{"age must not be negative", "email is required"}
+	if lefts, ok := invalid.UnwrapLeft(); !ok || !reflect.DeepEqual(lefts, expected) {
+		t.Errorf("expected Left(%v), but got %v, %t", expected, lefts, ok)
+	}
+}
+
+func TestCollect4(t *testing.T) {
+	errMissing := errors.New("missing")
+	present := Right[error](true)
+	missing := Left[error, bool](errMissing)
+
+	valid := Collect4(Right[error](1), Right[error]("a"), present, Right[error](2.5))
+	if tup, ok := valid.UnwrapRight(); !ok || tup != tuples.NewTup4(1, "a", true, 2.5) {
+		t.Errorf("expected Right({1 a true 2.5}), but got %v, %t", tup, ok)
+	}
+
+	invalid := Collect4(Right[error](1), Right[error]("a"), missing, Left[error, float64](errMissing))
+	if lefts, ok := invalid.UnwrapLeft(); !ok || len(lefts) != 2 {
+		t.Errorf("expected Left with two errors, but got %v, %t", lefts, ok)
+	}
+}
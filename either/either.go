@@ -36,3 +36,79 @@ func (e *either[A, B]) UnwrapLeft() (A, bool) {
 func (e *either[A, B]) UnwrapRight() (B, bool) {
 	return e.right, !e.isLeft
 }
+
+// Fold returns the result of calling onLeft with the value of a Left, or
+// onRight with the value of a Right. Exactly one of the functions is called.
+func Fold[A, B, C any](e Either[A, B], onLeft func(A) C, onRight func(B) C) C {
+	if left, ok := e.UnwrapLeft(); ok {
+		return onLeft(left)
+	}
+	right, _ := e.UnwrapRight()
+
+	return onRight(right)
+}
+
+// Match calls onLeft with the value of a Left, or onRight with the value of a
+// Right.
+func Match[A, B any](e Either[A, B], onLeft func(A), onRight func(B)) {
+	if left, ok := e.UnwrapLeft(); ok {
+		onLeft(left)
+
+		return
+	}
+	right, _ := e.UnwrapRight()
+	onRight(right)
+}
+
+// MapLeft applies a function to the left value of an Either, if it is Left.
+// A Right is returned unchanged.
+func MapLeft[A, B, C any](e Either[A, B], f func(A) C) Either[C, B] {
+	if left, ok := e.UnwrapLeft(); ok {
+		return Left[C, B](f(left))
+	}
+	right, _ := e.UnwrapRight()
+
+	return Right[C](right)
+}
+
+// MapRight applies a function to the right value of an Either, if it is Right.
+// A Left is returned unchanged.
+func MapRight[A, B, C any](e Either[A, B], f func(B) C) Either[A, C] {
+	if right, ok := e.UnwrapRight(); ok {
+		return Right[A](f(right))
+	}
+	left, _ := e.UnwrapLeft()
+
+	return Left[A, C](left)
+}
+
+// Bimap applies onLeft to the value of a Left, or onRight to the value of a Right.
+func Bimap[A, B, C, D any](e Either[A, B], onLeft func(A) C, onRight func(B) D) Either[C, D] {
+	if left, ok := e.UnwrapLeft(); ok {
+		return Left[C, D](onLeft(left))
+	}
+	right, _ := e.UnwrapRight()
+
+	return Right[C](onRight(right))
+}
+
+// FlatMap applies a function returning an Either to the right value of an
+// Either, if it is Right. A Left is returned unchanged.
+func FlatMap[A, B, C any](e Either[A, B], f func(B) Either[A, C]) Either[A, C] {
+	if right, ok := e.UnwrapRight(); ok {
+		return f(right)
+	}
+	left, _ := e.UnwrapLeft()
+
+	return Left[A, C](left)
+}
+
+// Swap turns a Left into a Right and vice versa.
+func Swap[A, B any](e Either[A, B]) Either[B, A] {
+	if left, ok := e.UnwrapLeft(); ok {
+		return Right[B](left)
+	}
+	right, _ := e.UnwrapRight()
+
+	return Left[B, A](right)
+}
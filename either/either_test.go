@@ -1,6 +1,9 @@
 package either
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestLeft(t *testing.T) {
 	testValue := 450
@@ -51,3 +54,149 @@ func TestRight(t *testing.T) {
 		t.Error("UnwrapLeft should not be ok for Right value")
 	}
 }
+
+func TestMapLeft(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+
+	mapped := MapLeft(Left[int, string](21), double)
+	if unwrapped, ok := mapped.UnwrapLeft(); !ok || unwrapped != 42 {
+		t.Errorf("expected Left(42), but got %d, %t", unwrapped, ok)
+	}
+
+	mapped = MapLeft(Right[int]("example"), double)
+	if unwrapped, ok := mapped.UnwrapRight(); !ok || unwrapped != "example" {
+		t.Errorf("expected Right(\"example\") to be unchanged, but got %q, %t", unwrapped, ok)
+	}
+}
+
+func TestMapRight(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	mapped := MapRight(Right[int]("example"), length)
+	if unwrapped, ok := mapped.UnwrapRight(); !ok || unwrapped != 7 {
+		t.Errorf("expected Right(7), but got %d, %t", unwrapped, ok)
+	}
+
+	mapped = MapRight(Left[int, string](450), length)
+	if unwrapped, ok := mapped.UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450) to be unchanged, but got %d, %t", unwrapped, ok)
+	}
+}
+
+func TestBimap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+	length := func(s string) int { return len(s) }
+
+	mapped := Bimap(Left[int, string](21), double, length)
+	if unwrapped, ok := mapped.UnwrapLeft(); !ok || unwrapped != 42 {
+		t.Errorf("expected Left(42), but got %d, %t", unwrapped, ok)
+	}
+
+	mapped = Bimap(Right[int]("example"), double, length)
+	if unwrapped, ok := mapped.UnwrapRight(); !ok || unwrapped != 7 {
+		t.Errorf("expected Right(7), but got %d, %t", unwrapped, ok)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	nonEmpty := func(s string) Either[int, string] {
+		if s == "" {
+			return Left[int, string](0)
+		}
+
+		return Right[int](s + "!")
+	}
+
+	mapped := FlatMap(Right[int]("example"), nonEmpty)
+	if unwrapped, ok := mapped.UnwrapRight(); !ok || unwrapped != "example!" {
+		t.Errorf("expected Right(\"example!\"), but got %q, %t", unwrapped, ok)
+	}
+
+	mapped = FlatMap(Right[int](""), nonEmpty)
+	if unwrapped, ok := mapped.UnwrapLeft(); !ok || unwrapped != 0 {
+		t.Errorf("expected Left(0), but got %d, %t", unwrapped, ok)
+	}
+
+	called := false
+	mapped = FlatMap(Left[int, string](450), func(s string) Either[int, string] {
+		called = true
+
+		return nonEmpty(s)
+	})
+	if unwrapped, ok := mapped.UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450) to be unchanged, but got %d, %t", unwrapped, ok)
+	}
+	if called {
+		t.Error("FlatMap called the function for Left")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	swapped := Swap(Left[int, string](450))
+	if unwrapped, ok := swapped.UnwrapRight(); !ok || unwrapped != 450 {
+		t.Errorf("expected Right(450), but got %d, %t", unwrapped, ok)
+	}
+
+	swapped2 := Swap(Right[int]("example"))
+	if unwrapped, ok := swapped2.UnwrapLeft(); !ok || unwrapped != "example" {
+		t.Errorf("expected Left(\"example\"), but got %q, %t", unwrapped, ok)
+	}
+}
+
+func TestFold(t *testing.T) {
+	leftCalls, rightCalls := 0, 0
+	describe := func(e Either[int, string]) string {
+		return Fold(e,
+			func(n int) string {
+				leftCalls++
+
+				return fmt.Sprintf("left %d", n)
+			},
+			func(s string) string {
+				rightCalls++
+
+				return "right " + s
+			},
+		)
+	}
+
+	if description := describe(Left[int, string](450)); description != "left 450" {
+		t.Errorf("expected \"left 450\", but got %q", description)
+	}
+	if leftCalls != 1 || rightCalls != 0 {
+		t.Errorf("expected Fold of Left to call onLeft only, but got %d onLeft and %d onRight calls", leftCalls, rightCalls)
+	}
+
+	leftCalls, rightCalls = 0, 0
+	if description := describe(Right[int]("example")); description != "right example" {
+		t.Errorf("expected \"right example\", but got %q", description)
+	}
+	if leftCalls != 0 || rightCalls != 1 {
+		t.Errorf("expected Fold of Right to call onRight only, but got %d onLeft and %d onRight calls", leftCalls, rightCalls)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	var leftValue int
+	var rightValue string
+	leftCalls, rightCalls := 0, 0
+	onLeft := func(n int) {
+		leftCalls++
+		leftValue = n
+	}
+	onRight := func(s string) {
+		rightCalls++
+		rightValue = s
+	}
+
+	Match(Left[int, string](450), onLeft, onRight)
+	if leftCalls != 1 || rightCalls != 0 || leftValue != 450 {
+		t.Errorf("expected Match of Left(450) to call onLeft with 450 only, but got %d onLeft calls with %d and %d onRight calls", leftCalls, leftValue, rightCalls)
+	}
+
+	leftCalls, rightCalls = 0, 0
+	Match(Right[int]("example"), onLeft, onRight)
+	if leftCalls != 0 || rightCalls != 1 || rightValue != "example" {
+		t.Errorf("expected Match of Right(\"example\") to call onRight with \"example\" only, but got %d onRight calls with %q and %d onLeft calls", rightCalls, rightValue, leftCalls)
+	}
+}
@@ -0,0 +1,45 @@
+package either
+
+import "reflect"
+
+// Equal returns true if two Eithers are both Left or both Right, with equal values.
+func Equal[A, B comparable](a, b Either[A, B]) bool {
+	return EqualFunc(a, b,
+		func(x, y A) bool { return x == y },
+		func(x, y B) bool { return x == y },
+	)
+}
+
+// EqualFunc returns true if two Eithers are both Left or both Right, with
+// values that are equal according to the matching function. Only the function
+// for the side that both are on is called.
+func EqualFunc[A, B any](a, b Either[A, B], eqLeft func(A, A) bool, eqRight func(B, B) bool) bool {
+	aLeft, aIsLeft := a.UnwrapLeft()
+	bLeft, bIsLeft := b.UnwrapLeft()
+	if aIsLeft != bIsLeft {
+		return false
+	}
+	if aIsLeft {
+		return eqLeft(aLeft, bLeft)
+	}
+	aRight, _ := a.UnwrapRight()
+	bRight, _ := b.UnwrapRight()
+
+	return eqRight(aRight, bRight)
+}
+
+// Equal returns true if the other Either is on the same side, with a deeply
+// equal value. It is picked up by go-cmp.
+func (e *either[A, B]) Equal(other Either[A, B]) bool {
+	return EqualFunc[A, B](e, other, deepEqual[A], deepEqual[B])
+}
+
+// Equal returns true if the other Either is on the same side, with a deeply
+// equal value. It is picked up by go-cmp.
+func (v Value[A, B]) Equal(other Either[A, B]) bool {
+	return EqualFunc[A, B](v, other, deepEqual[A], deepEqual[B])
+}
+
+func deepEqual[T any](x, y T) bool {
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,82 @@
+package either
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		a, b     Either[int, string]
+		expected bool
+	}{
+		{Left[int, string](1), Left[int, string](1), true},
+		{Left[int, string](1), Left[int, string](2), false},
+		{Right[int]("a"), Right[int]("a"), true},
+		{Right[int]("a"), Right[int]("b"), false},
+		{Left[int, string](0), Right[int](""), false},
+		{Right[int](""), Left[int, string](0), false},
+		{LeftValue[int, string](1), Left[int, string](1), true},
+		{Value[int, string]{}, Left[int, string](0), true},
+	}
+
+	for _, tc := range testCases {
+		if equal := Equal(tc.a, tc.b); equal != tc.expected {
+			t.Errorf("expected Equal(%v, %v) to be %t", tc.a, tc.b, tc.expected)
+		}
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	leftCalls, rightCalls := 0, 0
+	eqLeft := func(x, y int) bool {
+		leftCalls++
+
+		return x%10 == y%10
+	}
+	eqRight := func(x, y string) bool {
+		rightCalls++
+
+		return strings.EqualFold(x, y)
+	}
+
+	if !EqualFunc(Left[int, string](1), Left[int, string](11), eqLeft, eqRight) {
+		t.Error("expected Left(1) and Left(11) to be equal modulo 10")
+	}
+	if !EqualFunc(Right[int]("Example"), Right[int]("EXAMPLE"), eqLeft, eqRight) {
+		t.Error("expected Right(\"Example\") and Right(\"EXAMPLE\") to be equal ignoring case")
+	}
+	if leftCalls != 1 || rightCalls != 1 {
+		t.Errorf("expected one call to each function, but got %d and %d", leftCalls, rightCalls)
+	}
+
+	if EqualFunc(Left[int, string](1), Right[int]("1"), eqLeft, eqRight) {
+		t.Error("expected a Left and a Right to not be equal")
+	}
+	if leftCalls != 1 || rightCalls != 1 {
+		t.Error("EqualFunc called a function for Eithers on different sides")
+	}
+}
+
+func TestEither_Equal(t *testing.T) {
+	testCases := []struct {
+		a, b     Either[[]int, string]
+		expected bool
+	}{
+		{Left[[]int, string]([]int{1, 2}), Left[[]int, string]([]int{1, 2}), true},
+		{Left[[]int, string]([]int{1, 2}), Left[[]int, string]([]int{2, 1}), false},
+		{Right[[]int]("a"), Right[[]int]("a"), true},
+		{Left[[]int, string](nil), Right[[]int](""), false},
+		{RightValue[[]int]("a"), Right[[]int]("a"), true},
+		{LeftValue[[]int, string]([]int{1}), RightValue[[]int]("a"), false},
+	}
+
+	for _, tc := range testCases {
+		equaler := tc.a.(interface {
+			Equal(Either[[]int, string]) bool
+		})
+		if equal := equaler.Equal(tc.b); equal != tc.expected {
+			t.Errorf("expected %v.Equal(%v) to be %t", tc.a, tc.b, tc.expected)
+		}
+	}
+}
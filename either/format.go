@@ -0,0 +1,64 @@
+package either
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String returns Left(x) or Right(y), with the value formatted as by %v.
+func (e *either[A, B]) String() string {
+	return fmt.Sprint(e)
+}
+
+// GoString returns a Go expression that creates the Either, as used by %#v.
+func (e *either[A, B]) GoString() string {
+	return goString[A, B]("", e)
+}
+
+// Format implements fmt.Formatter. The Either is printed as Left(x) or
+// Right(y), with the verb and flags applied to the value.
+func (e *either[A, B]) Format(f fmt.State, verb rune) {
+	format[A, B](f, verb, e, e.GoString)
+}
+
+// String returns Left(x) or Right(y), with the value formatted as by %v.
+func (v Value[A, B]) String() string {
+	return fmt.Sprint(v)
+}
+
+// GoString returns a Go expression that creates the Value, as used by %#v.
+func (v Value[A, B]) GoString() string {
+	return goString[A, B]("Value", v)
+}
+
+// Format implements fmt.Formatter. The Value is printed as Left(x) or
+// Right(y), with the verb and flags applied to the value.
+func (v Value[A, B]) Format(f fmt.State, verb rune) {
+	format[A, B](f, verb, v, v.GoString)
+}
+
+func goString[A, B any](suffix string, e Either[A, B]) string {
+	types := fmt.Sprintf("[%v, %v]", reflect.TypeFor[A](), reflect.TypeFor[B]())
+	if left, ok := e.UnwrapLeft(); ok {
+		return fmt.Sprintf("either.Left%s%s(%#v)", suffix, types, left)
+	}
+	right, _ := e.UnwrapRight()
+
+	return fmt.Sprintf("either.Right%s%s(%#v)", suffix, types, right)
+}
+
+func format[A, B any](f fmt.State, verb rune, e Either[A, B], goString func() string) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, goString())
+
+		return
+	}
+
+	if left, ok := e.UnwrapLeft(); ok {
+		fmt.Fprintf(f, "Left("+fmt.FormatString(f, verb)+")", left)
+
+		return
+	}
+	right, _ := e.UnwrapRight()
+	fmt.Fprintf(f, "Right("+fmt.FormatString(f, verb)+")", right)
+}
@@ -0,0 +1,54 @@
+package either
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sjpeterson/typical/tuples"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		format   string
+		value    any
+		expected string
+	}{
+		{"%v", Left[int, string](450), "Left(450)"},
+		{"%v", Right[int]("example"), "Right(example)"},
+		{"%q", Right[int]("example"), `Right("example")`},
+		{"%x", Left[int, string](255), "Left(ff)"},
+		{"%05d", Left[int, string](42), "Left(00042)"},
+		{"%#v", Left[int, string](450), "either.Left[int, string](450)"},
+		{"%#v", Right[int]("example"), `either.Right[int, string]("example")`},
+		{"%v", LeftValue[int, string](450), "Left(450)"},
+		{"%q", RightValue[int]("example"), `Right("example")`},
+		{"%#v", RightValue[int]("example"), `either.RightValue[int, string]("example")`},
+		{"%v", Value[int, string]{}, "Left(0)"},
+		{"%v", []Either[int, string]{Left[int, string](1), Right[int]("a")}, "[Left(1) Right(a)]"},
+		{"%v", tuples.NewPair(Left[int, string](1), RightValue[int]("a")), "{Left(1) Right(a)}"},
+	}
+
+	for _, tc := range testCases {
+		if formatted := fmt.Sprintf(tc.format, tc.value); formatted != tc.expected {
+			t.Errorf("expected formatting with %q to give %q, but got %q", tc.format, tc.expected, formatted)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	testCases := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{Left[int, string](450).(fmt.Stringer), "Left(450)"},
+		{Right[int]("example").(fmt.Stringer), "Right(example)"},
+		{LeftValue[int, string](450), "Left(450)"},
+		{RightValue[int]("example"), "Right(example)"},
+	}
+
+	for _, tc := range testCases {
+		if s := tc.value.String(); s != tc.expected {
+			t.Errorf("expected %q, but got %q", tc.expected, s)
+		}
+	}
+}
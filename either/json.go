@@ -0,0 +1,201 @@
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	leftKey  = "left"
+	rightKey = "right"
+)
+
+// JSONOption configures how MarshalJSON and UnmarshalJSON encode an Either.
+type JSONOption func(*jsonConfig)
+
+type jsonConfig struct {
+	discriminator string
+	leftTag       string
+	rightTag      string
+	untagged      bool
+}
+
+// WithDiscriminator encodes an Either as its value, which must be a JSON
+// object, with an added field that holds leftTag for Left and rightTag for
+// Right, e.g. {"type": "circle", "radius": 1}. It is an error for the value
+// to already have a field with that name.
+func WithDiscriminator(field, leftTag, rightTag string) JSONOption {
+	return func(c *jsonConfig) {
+		c.discriminator, c.leftTag, c.rightTag = field, leftTag, rightTag
+		c.untagged = false
+	}
+}
+
+// Untagged encodes an Either as just its value. When decoding, the data is
+// decoded as Right if possible and as Left otherwise, so B should be the more
+// specific of the two types.
+func Untagged() JSONOption {
+	return func(c *jsonConfig) {
+		c.discriminator = ""
+		c.untagged = true
+	}
+}
+
+// MarshalJSON encodes an Either as {"left": value} or {"right": value}, or in
+// the form given by the options.
+func MarshalJSON[A, B any](e Either[A, B], options ...JSONOption) ([]byte, error) {
+	var config jsonConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	tag, key := config.rightTag, rightKey
+	var value any
+	if left, ok := e.UnwrapLeft(); ok {
+		tag, key, value = config.leftTag, leftKey, left
+	} else {
+		value, _ = e.UnwrapRight()
+	}
+
+	switch {
+	case config.untagged:
+		return json.Marshal(value)
+	case config.discriminator != "":
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+			return nil, fmt.Errorf("either: value with discriminator must encode as a JSON object, got %s", data)
+		}
+		if _, ok := fields[config.discriminator]; ok {
+			return nil, fmt.Errorf("either: value already has a field named like the discriminator %q", config.discriminator)
+		}
+		if fields[config.discriminator], err = json.Marshal(tag); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(fields)
+	default:
+		return json.Marshal(map[string]any{key: value})
+	}
+}
+
+// UnmarshalJSON decodes an Either encoded by MarshalJSON with the same options.
+func UnmarshalJSON[A, B any](data []byte, options ...JSONOption) (Either[A, B], error) {
+	var config jsonConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	if config.untagged {
+		var right B
+		errRight := json.Unmarshal(data, &right)
+		if errRight == nil {
+			return Right[A](right), nil
+		}
+		var left A
+		errLeft := json.Unmarshal(data, &left)
+		if errLeft == nil {
+			return Left[A, B](left), nil
+		}
+
+		return nil, fmt.Errorf("either: value is neither Right nor Left: %w", errors.Join(errRight, errLeft))
+	}
+
+	if config.discriminator == "" {
+		i, value, err := unmarshalTagged(data, leftKey, rightKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return unmarshalSide[A, B](value, i == 0)
+	}
+
+	fields, err := unmarshalObject(data)
+	if err != nil {
+		return nil, err
+	}
+	rawTag, ok := fields[config.discriminator]
+	if !ok {
+		return nil, fmt.Errorf("either: missing discriminator field %q", config.discriminator)
+	}
+	var tag string
+	if err := json.Unmarshal(rawTag, &tag); err != nil {
+		return nil, fmt.Errorf("either: decoding discriminator field %q: %w", config.discriminator, err)
+	}
+	switch tag {
+	case config.leftTag:
+		return unmarshalSide[A, B](data, true)
+	case config.rightTag:
+		return unmarshalSide[A, B](data, false)
+	default:
+		return nil, fmt.Errorf("either: unknown discriminator %q", tag)
+	}
+}
+
+func unmarshalSide[A, B any](data []byte, isLeft bool) (Either[A, B], error) {
+	if isLeft {
+		var left A
+		if err := json.Unmarshal(data, &left); err != nil {
+			return nil, err
+		}
+
+		return Left[A, B](left), nil
+	}
+	var right B
+	if err := json.Unmarshal(data, &right); err != nil {
+		return nil, err
+	}
+
+	return Right[A](right), nil
+}
+
+// unmarshalObject decodes a JSON object into its fields.
+func unmarshalObject(data []byte) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, errors.New("either: expected an object, got null")
+	}
+
+	return fields, nil
+}
+
+// unmarshalTagged decodes a JSON object with exactly one field, whose key is
+// one of the provided keys. It returns the index of the key and the value.
+func unmarshalTagged(data []byte, keys ...string) (int, json.RawMessage, error) {
+	fields, err := unmarshalObject(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(fields) == 1 {
+		for i, key := range keys {
+			if value, ok := fields[key]; ok {
+				return i, value, nil
+			}
+		}
+	}
+
+	return 0, nil, fmt.Errorf("either: expected an object with exactly one of %q", keys)
+}
+
+// MarshalJSON encodes the Either as {"left": value} or {"right": value}.
+func (e *either[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalJSON[A, B](e)
+}
+
+// UnmarshalJSON decodes an Either encoded as {"left": value} or {"right": value}.
+func (e *either[A, B]) UnmarshalJSON(data []byte) error {
+	decoded, err := UnmarshalJSON[A, B](data)
+	if err != nil {
+		return err
+	}
+	*e = *decoded.(*either[A, B])
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package either
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type circle struct {
+	Radius float64 `json:"radius"`
+}
+
+type rectangle struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+func TestEither_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		value    Either[int, string]
+		expected string
+	}{
+		{Left[int, string](450), `{"left":450}`},
+		{Right[int]("example"), `{"right":"example"}`},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("expected %s, but got %s", tc.expected, data)
+		}
+	}
+}
+
+func TestEither_UnmarshalJSON(t *testing.T) {
+	e := Left[int, string](0)
+
+	if err := json.Unmarshal([]byte(`{"right":"example"}`), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := e.UnwrapRight(); !ok || unwrapped != "example" {
+		t.Errorf("expected Right(\"example\"), but got %q, %t", unwrapped, ok)
+	}
+
+	if err := json.Unmarshal([]byte(`{"left":450}`), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := e.UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450), but got %d, %t", unwrapped, ok)
+	}
+
+	for _, data := range []string{`{}`, `{"left":1,"right":"x"}`, `{"middle":1}`, `null`, `{"left":"x"}`} {
+		if err := json.Unmarshal([]byte(data), e); err == nil {
+			t.Errorf("expected an error when decoding %s", data)
+		}
+	}
+}
+
+func TestMarshalJSON_WithDiscriminator(t *testing.T) {
+	option := WithDiscriminator("shape", "circle", "rectangle")
+
+	data, err := MarshalJSON(Left[circle, rectangle](circle{Radius: 1}), option)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"radius":1,"shape":"circle"}`; string(data) != expected {
+		t.Errorf("expected %s, but got %s", expected, data)
+	}
+
+	data, err = MarshalJSON(Right[circle](rectangle{Width: 2, Height: 3}), option)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"height":3,"shape":"rectangle","width":2}`; string(data) != expected {
+		t.Errorf("expected %s, but got %s", expected, data)
+	}
+
+	if _, err := MarshalJSON(Left[int, string](450), option); err == nil {
+		t.Error("expected an error when using a discriminator with a non-object value")
+	}
+
+	type clash struct {
+		Shape string `json:"shape"`
+	}
+	if _, err := MarshalJSON(Left[clash, rectangle](clash{Shape: "oval"}), option); err == nil {
+		t.Error("expected an error when the value has a field named like the discriminator")
+	}
+}
+
+func TestUnmarshalJSON_WithDiscriminator(t *testing.T) {
+	option := WithDiscriminator("shape", "circle", "rectangle")
+
+	shape, err := UnmarshalJSON[circle, rectangle]([]byte(`{"shape":"circle","radius":1}`), option)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := shape.UnwrapLeft(); !ok || unwrapped.Radius != 1 {
+		t.Errorf("expected Left(circle{1}), but got %v, %t", unwrapped, ok)
+	}
+
+	shape, err = UnmarshalJSON[circle, rectangle]([]byte(`{"shape":"rectangle","width":2,"height":3}`), option)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := shape.UnwrapRight(); !ok || unwrapped != (rectangle{2, 3}) {
+		t.Errorf("expected Right(rectangle{2, 3}), but got %v, %t", unwrapped, ok)
+	}
+
+	for _, data := range []string{`{"radius":1}`, `{"shape":"triangle"}`, `{"shape":1}`} {
+		if _, err := UnmarshalJSON[circle, rectangle]([]byte(data), option); err == nil {
+			t.Errorf("expected an error when decoding %s", data)
+		}
+	}
+}
+
+func TestJSON_Untagged(t *testing.T) {
+	data, err := MarshalJSON(Left[int, string](450), Untagged())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "450" {
+		t.Errorf("expected 450, but got %s", data)
+	}
+
+	e, err := UnmarshalJSON[int, string]([]byte(`"example"`), Untagged())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := e.UnwrapRight(); !ok || unwrapped != "example" {
+		t.Errorf("expected Right(\"example\"), but got %q, %t", unwrapped, ok)
+	}
+
+	e, err = UnmarshalJSON[int, string]([]byte(`450`), Untagged())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unwrapped, ok := e.UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450), but got %d, %t", unwrapped, ok)
+	}
+
+	if _, err := UnmarshalJSON[int, string]([]byte(`true`), Untagged()); err == nil {
+		t.Error("expected an error when the value is neither Right nor Left")
+	}
+}
@@ -0,0 +1,249 @@
+package either
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	firstKey  = "first"
+	secondKey = "second"
+	thirdKey  = "third"
+	fourthKey = "fourth"
+)
+
+// OneOf3[A, B, C] is a value with three possible types.
+type OneOf3[A, B, C any] interface {
+	IsFirst() bool
+	IsSecond() bool
+	IsThird() bool
+	UnwrapFirst() (A, bool)
+	UnwrapSecond() (B, bool)
+	UnwrapThird() (C, bool)
+}
+
+type oneOf3[A, B, C any] struct {
+	first  A
+	second B
+	third  C
+	index  int
+}
+
+// First3 creates a OneOf3 holding a value of the first type.
+func First3[A, B, C any](value A) OneOf3[A, B, C] {
+	return &oneOf3[A, B, C]{first: value, index: 1}
+}
+
+// Second3 creates a OneOf3 holding a value of the second type.
+func Second3[A, B, C any](value B) OneOf3[A, B, C] {
+	return &oneOf3[A, B, C]{second: value, index: 2}
+}
+
+// Third3 creates a OneOf3 holding a value of the third type.
+func Third3[A, B, C any](value C) OneOf3[A, B, C] {
+	return &oneOf3[A, B, C]{third: value, index: 3}
+}
+
+func (o *oneOf3[A, B, C]) IsFirst() bool {
+	return o.index == 1
+}
+
+func (o *oneOf3[A, B, C]) IsSecond() bool {
+	return o.index == 2
+}
+
+func (o *oneOf3[A, B, C]) IsThird() bool {
+	return o.index == 3
+}
+
+func (o *oneOf3[A, B, C]) UnwrapFirst() (A, bool) {
+	return o.first, o.index == 1
+}
+
+func (o *oneOf3[A, B, C]) UnwrapSecond() (B, bool) {
+	return o.second, o.index == 2
+}
+
+func (o *oneOf3[A, B, C]) UnwrapThird() (C, bool) {
+	return o.third, o.index == 3
+}
+
+// Match3 calls the function matching the type of the value held by a OneOf3.
+func Match3[A, B, C any](o OneOf3[A, B, C], onFirst func(A), onSecond func(B), onThird func(C)) {
+	if first, ok := o.UnwrapFirst(); ok {
+		onFirst(first)
+	} else if second, ok := o.UnwrapSecond(); ok {
+		onSecond(second)
+	} else if third, ok := o.UnwrapThird(); ok {
+		onThird(third)
+	}
+}
+
+// MarshalJSON encodes the OneOf3 as {"first": value}, {"second": value} or
+// {"third": value}.
+func (o *oneOf3[A, B, C]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return json.Marshal(map[string]A{firstKey: o.first})
+	case 2:
+		return json.Marshal(map[string]B{secondKey: o.second})
+	case 3:
+		return json.Marshal(map[string]C{thirdKey: o.third})
+	}
+
+	return nil, fmt.Errorf("either: invalid OneOf3 index %d", o.index)
+}
+
+// UnmarshalJSON decodes a OneOf3 encoded by MarshalJSON.
+func (o *oneOf3[A, B, C]) UnmarshalJSON(data []byte) error {
+	i, value, err := unmarshalTagged(data, firstKey, secondKey, thirdKey)
+	if err != nil {
+		return err
+	}
+
+	var decoded oneOf3[A, B, C]
+	switch i {
+	case 0:
+		err = json.Unmarshal(value, &decoded.first)
+	case 1:
+		err = json.Unmarshal(value, &decoded.second)
+	case 2:
+		err = json.Unmarshal(value, &decoded.third)
+	}
+	if err != nil {
+		return err
+	}
+	decoded.index = i + 1
+	*o = decoded
+
+	return nil
+}
+
+// OneOf4[A, B, C, D] is a value with four possible types.
+type OneOf4[A, B, C, D any] interface {
+	IsFirst() bool
+	IsSecond() bool
+	IsThird() bool
+	IsFourth() bool
+	UnwrapFirst() (A, bool)
+	UnwrapSecond() (B, bool)
+	UnwrapThird() (C, bool)
+	UnwrapFourth() (D, bool)
+}
+
+type oneOf4[A, B, C, D any] struct {
+	first  A
+	second B
+	third  C
+	fourth D
+	index  int
+}
+
+// First4 creates a OneOf4 holding a value of the first type.
+func First4[A, B, C, D any](value A) OneOf4[A, B, C, D] {
+	return &oneOf4[A, B, C, D]{first: value, index: 1}
+}
+
+// Second4 creates a OneOf4 holding a value of the second type.
+func Second4[A, B, C, D any](value B) OneOf4[A, B, C, D] {
+	return &oneOf4[A, B, C, D]{second: value, index: 2}
+}
+
+// Third4 creates a OneOf4 holding a value of the third type.
+func Third4[A, B, C, D any](value C) OneOf4[A, B, C, D] {
+	return &oneOf4[A, B, C, D]{third: value, index: 3}
+}
+
+// Fourth4 creates a OneOf4 holding a value of the fourth type.
+func Fourth4[A, B, C, D any](value D) OneOf4[A, B, C, D] {
+	return &oneOf4[A, B, C, D]{fourth: value, index: 4}
+}
+
+func (o *oneOf4[A, B, C, D]) IsFirst() bool {
+	return o.index == 1
+}
+
+func (o *oneOf4[A, B, C, D]) IsSecond() bool {
+	return o.index == 2
+}
+
+func (o *oneOf4[A, B, C, D]) IsThird() bool {
+	return o.index == 3
+}
+
+func (o *oneOf4[A, B, C, D]) IsFourth() bool {
+	return o.index == 4
+}
+
+func (o *oneOf4[A, B, C, D]) UnwrapFirst() (A, bool) {
+	return o.first, o.index == 1
+}
+
+func (o *oneOf4[A, B, C, D]) UnwrapSecond() (B, bool) {
+	return o.second, o.index == 2
+}
+
+func (o *oneOf4[A, B, C, D]) UnwrapThird() (C, bool) {
+	return o.third, o.index == 3
+}
+
+func (o *oneOf4[A, B, C, D]) UnwrapFourth() (D, bool) {
+	return o.fourth, o.index == 4
+}
+
+// Match4 calls the function matching the type of the value held by a OneOf4.
+func Match4[A, B, C, D any](o OneOf4[A, B, C, D], onFirst func(A), onSecond func(B), onThird func(C), onFourth func(D)) {
+	if first, ok := o.UnwrapFirst(); ok {
+		onFirst(first)
+	} else if second, ok := o.UnwrapSecond(); ok {
+		onSecond(second)
+	} else if third, ok := o.UnwrapThird(); ok {
+		onThird(third)
+	} else if fourth, ok := o.UnwrapFourth(); ok {
+		onFourth(fourth)
+	}
+}
+
+// MarshalJSON encodes the OneOf4 as {"first": value}, {"second": value},
+// {"third": value} or {"fourth": value}.
+func (o *oneOf4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return json.Marshal(map[string]A{firstKey: o.first})
+	case 2:
+		return json.Marshal(map[string]B{secondKey: o.second})
+	case 3:
+		return json.Marshal(map[string]C{thirdKey: o.third})
+	case 4:
+		return json.Marshal(map[string]D{fourthKey: o.fourth})
+	}
+
+	return nil, fmt.Errorf("either: invalid OneOf4 index %d", o.index)
+}
+
+// UnmarshalJSON decodes a OneOf4 encoded by MarshalJSON.
+func (o *oneOf4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	i, value, err := unmarshalTagged(data, firstKey, secondKey, thirdKey, fourthKey)
+	if err != nil {
+		return err
+	}
+
+	var decoded oneOf4[A, B, C, D]
+	switch i {
+	case 0:
+		err = json.Unmarshal(value, &decoded.first)
+	case 1:
+		err = json.Unmarshal(value, &decoded.second)
+	case 2:
+		err = json.Unmarshal(value, &decoded.third)
+	case 3:
+		err = json.Unmarshal(value, &decoded.fourth)
+	}
+	if err != nil {
+		return err
+	}
+	decoded.index = i + 1
+	*o = decoded
+
+	return nil
+}
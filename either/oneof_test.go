@@ -0,0 +1,138 @@
+package either
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOneOf3(t *testing.T) {
+	first := First3[int, string, bool](450)
+	if !first.IsFirst() || first.IsSecond() || first.IsThird() {
+		t.Error("expected First3 to be first only")
+	}
+	if unwrapped, ok := first.UnwrapFirst(); !ok || unwrapped != 450 {
+		t.Errorf("expected UnwrapFirst to give 450, true, but got %d, %t", unwrapped, ok)
+	}
+	if _, ok := first.UnwrapSecond(); ok {
+		t.Error("UnwrapSecond should not be ok for First3 value")
+	}
+
+	second := Second3[int, string, bool]("example")
+	if second.IsFirst() || !second.IsSecond() || second.IsThird() {
+		t.Error("expected Second3 to be second only")
+	}
+	if unwrapped, ok := second.UnwrapSecond(); !ok || unwrapped != "example" {
+		t.Errorf("expected UnwrapSecond to give \"example\", true, but got %q, %t", unwrapped, ok)
+	}
+
+	third := Third3[int, string](true)
+	if third.IsFirst() || third.IsSecond() || !third.IsThird() {
+		t.Error("expected Third3 to be third only")
+	}
+	if unwrapped, ok := third.UnwrapThird(); !ok || !unwrapped {
+		t.Errorf("expected UnwrapThird to give true, true, but got %t, %t", unwrapped, ok)
+	}
+	if _, ok := third.UnwrapFirst(); ok {
+		t.Error("UnwrapFirst should not be ok for Third3 value")
+	}
+}
+
+func TestMatch3(t *testing.T) {
+	var matched []string
+	match := func(o OneOf3[int, string, bool]) {
+		Match3(o,
+			func(int) { matched = append(matched, "first") },
+			func(string) { matched = append(matched, "second") },
+			func(bool) { matched = append(matched, "third") },
+		)
+	}
+
+	match(First3[int, string, bool](1))
+	match(Second3[int, string, bool]("a"))
+	match(Third3[int, string](true))
+
+	if len(matched) != 3 || matched[0] != "first" || matched[1] != "second" || matched[2] != "third" {
+		t.Errorf("expected [first second third], but got %v", matched)
+	}
+}
+
+func TestOneOf4(t *testing.T) {
+	fourth := Fourth4[int, string, bool](2.5)
+	if fourth.IsFirst() || fourth.IsSecond() || fourth.IsThird() || !fourth.IsFourth() {
+		t.Error("expected Fourth4 to be fourth only")
+	}
+	if unwrapped, ok := fourth.UnwrapFourth(); !ok || unwrapped != 2.5 {
+		t.Errorf("expected UnwrapFourth to give 2.5, true, but got %v, %t", unwrapped, ok)
+	}
+
+	first := First4[int, string, bool, float64](450)
+	if !first.IsFirst() || first.IsFourth() {
+		t.Error("expected First4 to be first only")
+	}
+	if _, ok := first.UnwrapFourth(); ok {
+		t.Error("UnwrapFourth should not be ok for First4 value")
+	}
+}
+
+func TestMatch4(t *testing.T) {
+	var matched []string
+	match := func(o OneOf4[int, string, bool, float64]) {
+		Match4(o,
+			func(int) { matched = append(matched, "first") },
+			func(string) { matched = append(matched, "second") },
+			func(bool) { matched = append(matched, "third") },
+			func(float64) { matched = append(matched, "fourth") },
+		)
+	}
+
+	match(First4[int, string, bool, float64](1))
+	match(Second4[int, string, bool, float64]("a"))
+	match(Third4[int, string, bool, float64](true))
+	match(Fourth4[int, string, bool](2.5))
+
+	expected := []string{"first", "second", "third", "fourth"}
+	if len(matched) != len(expected) {
+		t.Fatalf("expected %v, but got %v", expected, matched)
+	}
+	for i := range expected {
+		if matched[i] != expected[i] {
+			t.Errorf("expected %v, but got %v", expected, matched)
+		}
+	}
+}
+
+func TestOneOf_JSON(t *testing.T) {
+	testCases := []struct {
+		value    any
+		decoded  json.Unmarshaler
+		expected string
+	}{
+		{First3[int, string, bool](450), Second3[int, string, bool]("").(json.Unmarshaler), `{"first":450}`},
+		{Second3[int, string, bool]("example"), First3[int, string, bool](0).(json.Unmarshaler), `{"second":"example"}`},
+		{Third3[int, string](true), First3[int, string, bool](0).(json.Unmarshaler), `{"third":true}`},
+		{Fourth4[int, string, bool](2.5), First4[int, string, bool, float64](0).(json.Unmarshaler), `{"fourth":2.5}`},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("expected %s, but got %s", tc.expected, data)
+		}
+
+		if err := json.Unmarshal(data, tc.decoded); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if redata, _ := json.Marshal(tc.decoded); string(redata) != tc.expected {
+			t.Errorf("expected %s to round trip, but got %s", tc.expected, redata)
+		}
+	}
+
+	for _, data := range []string{`{}`, `{"fifth":1}`, `{"first":1,"second":"a"}`, `{"first":"a"}`, `null`} {
+		if err := json.Unmarshal([]byte(data), First4[int, string, bool, float64](0)); err == nil {
+			t.Errorf("expected an error when decoding %s", data)
+		}
+	}
+}
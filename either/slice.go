@@ -0,0 +1,48 @@
+package either
+
+import "github.com/sjpeterson/typical/tuples"
+
+// Lefts returns the values of all Lefts in a slice of Eithers, in order.
+func Lefts[A, B any](xs []Either[A, B]) []A {
+	lefts := make([]A, 0, len(xs))
+	for _, x := range xs {
+		if left, ok := x.UnwrapLeft(); ok {
+			lefts = append(lefts, left)
+		}
+	}
+
+	return lefts
+}
+
+// Rights returns the values of all Rights in a slice of Eithers, in order.
+func Rights[A, B any](xs []Either[A, B]) []B {
+	rights := make([]B, 0, len(xs))
+	for _, x := range xs {
+		if right, ok := x.UnwrapRight(); ok {
+			rights = append(rights, right)
+		}
+	}
+
+	return rights
+}
+
+// Partition splits a slice of Eithers into the values of the Lefts and the
+// values of the Rights, keeping the order of each.
+func Partition[A, B any](xs []Either[A, B]) ([]A, []B) {
+	lefts := make([]A, 0)
+	rights := make([]B, 0)
+	for _, x := range xs {
+		if left, ok := x.UnwrapLeft(); ok {
+			lefts = append(lefts, left)
+		} else if right, ok := x.UnwrapRight(); ok {
+			rights = append(rights, right)
+		}
+	}
+
+	return lefts, rights
+}
+
+// PartitionPairs is like Partition, but returns the result as a Pair.
+func PartitionPairs[A, B any](xs []Either[A, B]) tuples.Pair[[]A, []B] {
+	return tuples.NewPair(Partition(xs))
+}
@@ -0,0 +1,56 @@
+package either
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testEithers() []Either[int, string] {
+	return []Either[int, string]{
+		Right[int]("a"),
+		Left[int, string](1),
+		Right[int]("b"),
+		Left[int, string](2),
+		Right[int]("c"),
+	}
+}
+
+func TestLefts(t *testing.T) {
+	if lefts := Lefts(testEithers()); !reflect.DeepEqual(lefts, []int{1, 2}) {
+		t.Errorf("expected [1 2], but got %v", lefts)
+	}
+	if lefts := Lefts[int, string](nil); lefts == nil || len(lefts) != 0 {
+		t.Errorf("expected an empty slice, but got %#v", lefts)
+	}
+}
+
+func TestRights(t *testing.T) {
+	if rights := Rights(testEithers()); !reflect.DeepEqual(rights, []string{"a", "b", "c"}) {
+		t.Errorf("expected [a b c], but got %v", rights)
+	}
+	if rights := Rights[int, string](nil); rights == nil || len(rights) != 0 {
+		t.Errorf("expected an empty slice, but got %#v", rights)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	lefts, rights := Partition(testEithers())
+
+	if !reflect.DeepEqual(lefts, []int{1, 2}) {
+		t.Errorf("expected lefts to be [1 2], but got %v", lefts)
+	}
+	if !reflect.DeepEqual(rights, []string{"a", "b", "c"}) {
+		t.Errorf("expected rights to be [a b c], but got %v", rights)
+	}
+}
+
+func TestPartitionPairs(t *testing.T) {
+	lefts, rights := PartitionPairs(testEithers()).Destructure()
+
+	if !reflect.DeepEqual(lefts, []int{1, 2}) {
+		t.Errorf("expected lefts to be [1 2], but got %v", lefts)
+	}
+	if !reflect.DeepEqual(rights, []string{"a", "b", "c"}) {
+		t.Errorf("expected rights to be [a b c], but got %v", rights)
+	}
+}
@@ -0,0 +1,75 @@
+package either
+
+// Value[A, B] is an Either that is a plain value rather than an interface.
+// Creating one does not allocate, and its zero value is well defined: it is a
+// Left holding the zero value of A. Note that passing a Value to a function
+// that takes an Either converts it to an interface, which may allocate.
+//
+// A Value is not smaller than the Either created by Left and Right. Go has no
+// union types, and overlapping A and B in memory would hide pointers from the
+// garbage collector, so it holds room for both. It is stored inline instead
+// of behind a pointer.
+type Value[A, B any] struct {
+	left    A
+	right   B
+	isRight bool
+}
+
+var _ Either[int, string] = Value[int, string]{}
+
+// LeftValue creates a Value holding a left value.
+func LeftValue[A, B any](value A) Value[A, B] {
+	return Value[A, B]{left: value}
+}
+
+// RightValue creates a Value holding a right value.
+func RightValue[A, B any](value B) Value[A, B] {
+	return Value[A, B]{right: value, isRight: true}
+}
+
+// ValueOf converts an Either to a Value.
+func ValueOf[A, B any](e Either[A, B]) Value[A, B] {
+	if right, ok := e.UnwrapRight(); ok {
+		return RightValue[A](right)
+	}
+	left, _ := e.UnwrapLeft()
+
+	return LeftValue[A, B](left)
+}
+
+// Either converts the Value to an Either.
+func (v Value[A, B]) Either() Either[A, B] {
+	return &either[A, B]{left: v.left, right: v.right, isLeft: !v.isRight}
+}
+
+func (v Value[A, B]) IsLeft() bool {
+	return !v.isRight
+}
+
+func (v Value[A, B]) IsRight() bool {
+	return v.isRight
+}
+
+func (v Value[A, B]) UnwrapLeft() (A, bool) {
+	return v.left, !v.isRight
+}
+
+func (v Value[A, B]) UnwrapRight() (B, bool) {
+	return v.right, v.isRight
+}
+
+// MarshalJSON encodes the Value as {"left": value} or {"right": value}.
+func (v Value[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalJSON[A, B](v)
+}
+
+// UnmarshalJSON decodes a Value encoded as {"left": value} or {"right": value}.
+func (v *Value[A, B]) UnmarshalJSON(data []byte) error {
+	decoded, err := UnmarshalJSON[A, B](data)
+	if err != nil {
+		return err
+	}
+	*v = ValueOf(decoded)
+
+	return nil
+}
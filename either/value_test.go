@@ -0,0 +1,161 @@
+package either
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValue_ZeroValueIsLeftZero(t *testing.T) {
+	var zero Value[int, string]
+
+	if !zero.IsLeft() || zero.IsRight() {
+		t.Error("expected the zero Value to be Left")
+	}
+	if unwrapped, ok := zero.UnwrapLeft(); !ok || unwrapped != 0 {
+		t.Errorf("expected the zero Value to unwrap to Left(0), but got %d, %t", unwrapped, ok)
+	}
+	if _, ok := zero.UnwrapRight(); ok {
+		t.Error("UnwrapRight should not be ok for the zero Value")
+	}
+}
+
+func TestLeftValue(t *testing.T) {
+	wrapped := LeftValue[int, string](450)
+
+	if !wrapped.IsLeft() || wrapped.IsRight() {
+		t.Error("expected LeftValue to be Left")
+	}
+	if unwrapped, ok := wrapped.UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450), but got %d, %t", unwrapped, ok)
+	}
+}
+
+func TestRightValue(t *testing.T) {
+	wrapped := RightValue[int]("example")
+
+	if wrapped.IsLeft() || !wrapped.IsRight() {
+		t.Error("expected RightValue to be Right")
+	}
+	if unwrapped, ok := wrapped.UnwrapRight(); !ok || unwrapped != "example" {
+		t.Errorf("expected Right(\"example\"), but got %q, %t", unwrapped, ok)
+	}
+	if _, ok := wrapped.UnwrapLeft(); ok {
+		t.Error("UnwrapLeft should not be ok for RightValue")
+	}
+}
+
+func TestValueOf(t *testing.T) {
+	if ValueOf(Left[int, string](450)) != LeftValue[int, string](450) {
+		t.Error("expected ValueOf(Left(450)) to be LeftValue(450)")
+	}
+	if ValueOf(Right[int]("example")) != RightValue[int]("example") {
+		t.Error("expected ValueOf(Right(\"example\")) to be RightValue(\"example\")")
+	}
+}
+
+func TestValue_Either(t *testing.T) {
+	if unwrapped, ok := LeftValue[int, string](450).Either().UnwrapLeft(); !ok || unwrapped != 450 {
+		t.Errorf("expected Left(450), but got %d, %t", unwrapped, ok)
+	}
+	if unwrapped, ok := RightValue[int]("example").Either().UnwrapRight(); !ok || unwrapped != "example" {
+		t.Errorf("expected Right(\"example\"), but got %q, %t", unwrapped, ok)
+	}
+}
+
+func TestValue_JSON(t *testing.T) {
+	type payload struct {
+		Result Value[int, string] `json:"result"`
+	}
+
+	data, err := json.Marshal(payload{RightValue[int]("example")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"result":{"right":"example"}}`; string(data) != expected {
+		t.Errorf("expected %s, but got %s", expected, data)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal([]byte(`{"result":{"left":450}}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Result != LeftValue[int, string](450) {
+		t.Errorf("expected LeftValue(450), but got %v", decoded.Result)
+	}
+}
+
+func TestValue_DoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		v := RightValue[int]("example")
+		if v.IsRight() {
+			v = LeftValue[int, string](450)
+		}
+		valueSink, _ = v.UnwrapLeft()
+	})
+
+	if allocs != 0 {
+		t.Errorf("expected no allocations, but got %v per run", allocs)
+	}
+}
+
+var (
+	eitherSink Either[int, string]
+	valueSink  int
+)
+
+func BenchmarkLeft(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		eitherSink = Left[int, string](i)
+	}
+}
+
+func BenchmarkLeftValue(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v := LeftValue[int, string](i)
+		valueSink, _ = v.UnwrapLeft()
+	}
+}
+
+func BenchmarkRight(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		eitherSink = Right[int]("example")
+	}
+}
+
+func BenchmarkRightValue(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v := RightValue[int]("example")
+		right, _ := v.UnwrapRight()
+		valueSink = len(right)
+	}
+}
+
+func BenchmarkEitherStruct(b *testing.B) {
+	type record struct {
+		id     int
+		result Either[int, string]
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := record{id: i, result: Left[int, string](i)}
+		eitherSink = r.result
+	}
+}
+
+func BenchmarkValueStruct(b *testing.B) {
+	type record struct {
+		id     int
+		result Value[int, string]
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := record{id: i, result: LeftValue[int, string](i)}
+		valueSink, _ = r.result.UnwrapLeft()
+	}
+}
@@ -0,0 +1,50 @@
+package maybe
+
+// FromPointer creates a Maybe from a pointer. A nil pointer gives None, and
+// any other pointer gives Some with a copy of the value it points to.
+func FromPointer[T any](pointer *T) Maybe[T] {
+	if pointer == nil {
+		return None[T]()
+	}
+
+	return Some(*pointer)
+}
+
+// ToPointer returns a pointer to a copy of the value of the Maybe, or nil for
+// None. Changing the value through the pointer does not change the Maybe.
+func ToPointer[T any](m Maybe[T]) *T {
+	value, ok := m.Unwrap()
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
+
+// FromOk creates a Maybe from a value and a bool indicating whether or not it
+// is valid, as returned by comma-ok expressions and many functions.
+func FromOk[T any](value T, ok bool) Maybe[T] {
+	if !ok {
+		return None[T]()
+	}
+
+	return Some(value)
+}
+
+// Lookup returns the value stored in a map under a key, or None if the key is
+// not present.
+func Lookup[K comparable, V any](m map[K]V, key K) Maybe[V] {
+	value, ok := m[key]
+
+	return FromOk(value, ok)
+}
+
+// Index returns the element of a slice at an index, or None if the index is
+// out of range.
+func Index[T any](slice []T, i int) Maybe[T] {
+	if i < 0 || i >= len(slice) {
+		return None[T]()
+	}
+
+	return Some(slice[i])
+}
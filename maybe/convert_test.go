@@ -0,0 +1,73 @@
+package maybe
+
+import "testing"
+
+func TestFromPointer(t *testing.T) {
+	original := 6
+	m := FromPointer(&original)
+	original = 7
+
+	if value, ok := m.Unwrap(); !ok || value != 6 {
+		t.Errorf("expected FromPointer(&6) to be Some(6), but got %d, %t", value, ok)
+	}
+
+	if FromPointer[int](nil).IsSome() {
+		t.Error("FromPointer[int](nil).IsSome() is true")
+	}
+}
+
+func TestToPointer(t *testing.T) {
+	m := Some(6)
+	pointer := ToPointer(m)
+	if pointer == nil {
+		t.Fatal("ToPointer(Some(6)) is nil")
+	}
+	if *pointer != 6 {
+		t.Errorf("expected ToPointer(Some(6)) to point to 6, but got %d", *pointer)
+	}
+
+	*pointer = 7
+	if value, _ := m.Unwrap(); value != 6 {
+		t.Errorf("changing the value through the pointer changed the Maybe to %d", value)
+	}
+
+	if ToPointer(None[int]()) != nil {
+		t.Error("ToPointer(None[int]()) is not nil")
+	}
+}
+
+func TestFromOk(t *testing.T) {
+	if value, ok := FromOk("test", true).Unwrap(); !ok || value != "test" {
+		t.Errorf("expected FromOk(\"test\", true) to be Some(\"test\"), but got %q, %t", value, ok)
+	}
+	if FromOk("test", false).IsSome() {
+		t.Error("FromOk(\"test\", false).IsSome() is true")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	testMap := map[string]int{"zero": 0, "one": 1}
+
+	if value, ok := Lookup(testMap, "zero").Unwrap(); !ok || value != 0 {
+		t.Errorf("expected Lookup of present key to be Some(0), but got %d, %t", value, ok)
+	}
+	if Lookup(testMap, "two").IsSome() {
+		t.Error("Lookup of missing key is Some")
+	}
+	if Lookup[string, int](nil, "zero").IsSome() {
+		t.Error("Lookup in nil map is Some")
+	}
+}
+
+func TestIndex(t *testing.T) {
+	testSlice := []string{"a", "b", "c"}
+
+	if value, ok := Index(testSlice, 2).Unwrap(); !ok || value != "c" {
+		t.Errorf("expected Index(slice, 2) to be Some(\"c\"), but got %q, %t", value, ok)
+	}
+	for _, i := range []int{-1, 3} {
+		if Index(testSlice, i).IsSome() {
+			t.Errorf("Index(slice, %d) is Some", i)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package maybe
+
+import (
+	"fmt"
+	"os"
+)
+
+// LookupEnv returns the value of an environment variable, parsed with the
+// provided function. An unset variable gives None, while a variable that is
+// set but can't be parsed gives an error.
+func LookupEnv[T any](name string, parse func(string) (T, error)) (Maybe[T], error) {
+	s, ok := os.LookupEnv(name)
+	if !ok {
+		return None[T](), nil
+	}
+
+	value, err := parse(s)
+	if err != nil {
+		return None[T](), fmt.Errorf("maybe: parsing environment variable %s: %w", name, err)
+	}
+
+	return Some(value), nil
+}
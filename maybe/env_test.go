@@ -0,0 +1,42 @@
+package maybe
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestLookupEnv(t *testing.T) {
+	t.Setenv("MAYBE_TEST_PORT", "8080")
+	t.Setenv("MAYBE_TEST_ZERO", "0")
+	t.Setenv("MAYBE_TEST_BAD", "eighty")
+
+	port, err := LookupEnv("MAYBE_TEST_PORT", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := port.Unwrap(); !ok || value != 8080 {
+		t.Errorf("expected Some(8080), but got %d, %t", value, ok)
+	}
+
+	zero, err := LookupEnv("MAYBE_TEST_ZERO", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := zero.Unwrap(); !ok || value != 0 {
+		t.Errorf("expected Some(0), but got %d, %t", value, ok)
+	}
+
+	unset, err := LookupEnv("MAYBE_TEST_UNSET", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unset.IsSome() {
+		t.Error("expected unset variable to be None")
+	}
+
+	_, err = LookupEnv("MAYBE_TEST_BAD", strconv.Atoi)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error, but got %v", err)
+	}
+}
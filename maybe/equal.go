@@ -0,0 +1,37 @@
+package maybe
+
+import "reflect"
+
+// Equal returns true if two Maybes are both None, or both Some with equal values.
+func Equal[T comparable](a, b Maybe[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc returns true if two Maybes are both None, or both Some with values
+// that are equal according to the provided function. The function is only
+// called if both are Some.
+func EqualFunc[T, U any](a Maybe[T], b Maybe[U], eq func(T, U) bool) bool {
+	aValue, aOk := a.Unwrap()
+	bValue, bOk := b.Unwrap()
+	if !aOk || !bOk {
+		return aOk == bOk
+	}
+
+	return eq(aValue, bValue)
+}
+
+// Equal returns true if the other Maybe is also None, or if both are Some
+// with deeply equal values. It is picked up by go-cmp.
+func (m *maybe[T]) Equal(other Maybe[T]) bool {
+	return EqualFunc[T, T](m, other, deepEqual[T])
+}
+
+// Equal returns true if the other Maybe is also None, or if both are Some
+// with deeply equal values. It is picked up by go-cmp.
+func (o Option[T]) Equal(other Maybe[T]) bool {
+	return EqualFunc[T, T](o, other, deepEqual[T])
+}
+
+func deepEqual[T any](x, y T) bool {
+	return reflect.DeepEqual(x, y)
+}
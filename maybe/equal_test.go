@@ -0,0 +1,75 @@
+package maybe
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		a, b     Maybe[int]
+		expected bool
+	}{
+		{Some(1), Some(1), true},
+		{Some(1), Some(2), false},
+		{Some(0), None[int](), false},
+		{None[int](), Some(0), false},
+		{None[int](), None[int](), true},
+		{SomeOption(1), Some(1), true},
+		{NoneOption[int](), None[int](), true},
+	}
+
+	for _, tc := range testCases {
+		if equal := Equal(tc.a, tc.b); equal != tc.expected {
+			t.Errorf("expected Equal(%v, %v) to be %t", tc.a, tc.b, tc.expected)
+		}
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	called := false
+	eq := func(x, y string) bool {
+		called = true
+
+		return strings.EqualFold(x, y)
+	}
+
+	if !EqualFunc(Some("Test"), Some("TEST"), eq) {
+		t.Error("expected EqualFunc(Some(\"Test\"), Some(\"TEST\"), strings.EqualFold) to be true")
+	}
+	if EqualFunc(Some("Test"), Some("other"), eq) {
+		t.Error("expected EqualFunc(Some(\"Test\"), Some(\"other\"), strings.EqualFold) to be false")
+	}
+
+	called = false
+	if EqualFunc(Some("Test"), None[string](), eq) {
+		t.Error("expected EqualFunc(Some(\"Test\"), None[string](), eq) to be false")
+	}
+	if !EqualFunc(None[string](), None[string](), eq) {
+		t.Error("expected EqualFunc(None[string](), None[string](), eq) to be true")
+	}
+	if called {
+		t.Error("EqualFunc called the function when not both were Some")
+	}
+}
+
+func TestMaybe_Equal(t *testing.T) {
+	testCases := []struct {
+		a, b     Maybe[[]int]
+		expected bool
+	}{
+		{Some([]int{1, 2}), Some([]int{1, 2}), true},
+		{Some([]int{1, 2}), Some([]int{2, 1}), false},
+		{Some([]int{}), None[[]int](), false},
+		{None[[]int](), None[[]int](), true},
+		{SomeOption([]int{1}), Some([]int{1}), true},
+		{NoneOption[[]int](), SomeOption([]int{1}), false},
+	}
+
+	for _, tc := range testCases {
+		equaler := tc.a.(interface{ Equal(Maybe[[]int]) bool })
+		if equal := equaler.Equal(tc.b); equal != tc.expected {
+			t.Errorf("expected %v.Equal(%v) to be %t", tc.a, tc.b, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package maybe
+
+import (
+	"fmt"
+	"time"
+)
+
+// FlagType is the set of types supported by Flag.
+type FlagType interface {
+	string | int | int64 | uint | uint64 | bool | float64 | time.Duration
+}
+
+// Flag[T] is a Maybe that implements flag.Value, for command-line flags where
+// not being set is different from being set to the zero value. Its zero value
+// is None and ready to use:
+//
+//	var timeout maybe.Flag[time.Duration]
+//	flag.Var(&timeout, "timeout", "request timeout")
+type Flag[T FlagType] struct {
+	option Option[T]
+}
+
+// IsSome returns true if the flag has been set.
+func (f *Flag[T]) IsSome() bool {
+	return f.option.IsSome()
+}
+
+// IsNone returns true if the flag has not been set.
+func (f *Flag[T]) IsNone() bool {
+	return f.option.IsNone()
+}
+
+// Unwrap returns the value of the flag (if any) and a bool indicating whether or not it has been set.
+func (f *Flag[T]) Unwrap() (T, bool) {
+	return f.option.Unwrap()
+}
+
+// Set parses the flag value and makes the flag Some. Like the flag package,
+// it accepts integers with a base prefix such as 0x. It implements flag.Value.
+func (f *Flag[T]) Set(s string) error {
+	value, err := parseText[T](s, 0)
+	if err != nil {
+		return err
+	}
+	f.option = SomeOption(value)
+
+	return nil
+}
+
+// String returns the value of the flag, or an empty string if it has not been
+// set. It implements flag.Value.
+func (f *Flag[T]) String() string {
+	if f == nil {
+		return ""
+	}
+	value, ok := f.option.Unwrap()
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// Get returns the flag as a Maybe[T]. It implements flag.Getter.
+func (f *Flag[T]) Get() any {
+	return f.option.Maybe()
+}
+
+// IsBoolFlag returns true for Flag[bool], which allows it to be set without a
+// value on the command line, e.g. -verbose.
+func (f *Flag[T]) IsBoolFlag() bool {
+	_, isBool := any(f.option.value).(bool)
+
+	return isBool
+}
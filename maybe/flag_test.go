@@ -0,0 +1,114 @@
+package maybe
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	return flags
+}
+
+func TestFlag(t *testing.T) {
+	var (
+		name    Flag[string]
+		count   Flag[int]
+		verbose Flag[bool]
+		timeout Flag[time.Duration]
+		ratio   Flag[float64]
+		unset   Flag[int]
+	)
+	flags := newTestFlagSet()
+	flags.Var(&name, "name", "")
+	flags.Var(&count, "count", "")
+	flags.Var(&verbose, "verbose", "")
+	flags.Var(&timeout, "timeout", "")
+	flags.Var(&ratio, "ratio", "")
+	flags.Var(&unset, "unset", "")
+
+	args := []string{"-name", "test", "-count", "0", "-verbose", "-timeout", "1m30s", "-ratio", "0.5"}
+	if err := flags.Parse(args); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value, ok := name.Unwrap(); !ok || value != "test" {
+		t.Errorf("expected name to be Some(\"test\"), but got %q, %t", value, ok)
+	}
+	if value, ok := count.Unwrap(); !ok || value != 0 {
+		t.Errorf("expected count to be Some(0), but got %d, %t", value, ok)
+	}
+	if value, ok := verbose.Unwrap(); !ok || !value {
+		t.Errorf("expected verbose to be Some(true), but got %t, %t", value, ok)
+	}
+	if value, ok := timeout.Unwrap(); !ok || value != 90*time.Second {
+		t.Errorf("expected timeout to be Some(1m30s), but got %v, %t", value, ok)
+	}
+	if value, ok := ratio.Unwrap(); !ok || value != 0.5 {
+		t.Errorf("expected ratio to be Some(0.5), but got %v, %t", value, ok)
+	}
+	if unset.IsSome() {
+		t.Error("expected unset flag to be None")
+	}
+}
+
+func TestFlag_ParseError(t *testing.T) {
+	var count Flag[int]
+	flags := newTestFlagSet()
+	flags.Var(&count, "count", "")
+
+	if err := flags.Parse([]string{"-count", "many"}); err == nil {
+		t.Error("expected an error when parsing a malformed int flag")
+	}
+	if count.IsSome() {
+		t.Error("expected malformed flag to remain None")
+	}
+}
+
+func TestFlag_String(t *testing.T) {
+	var timeout Flag[time.Duration]
+	if s := timeout.String(); s != "" {
+		t.Errorf("expected unset flag to be \"\", but got %q", s)
+	}
+
+	if err := timeout.Set("2s"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := timeout.String(); s != "2s" {
+		t.Errorf("expected \"2s\", but got %q", s)
+	}
+
+	if m, ok := timeout.Get().(Maybe[time.Duration]); !ok || !Equal(m, Some(2*time.Second)) {
+		t.Errorf("expected Get() to be Some(2s), but got %v", timeout.Get())
+	}
+}
+
+func TestFlag_BasePrefix(t *testing.T) {
+	var (
+		mask  Flag[int64]
+		mode  Flag[uint]
+		count Flag[int]
+	)
+	flags := newTestFlagSet()
+	flags.Var(&mask, "mask", "")
+	flags.Var(&mode, "mode", "")
+	flags.Var(&count, "count", "")
+
+	if err := flags.Parse([]string{"-mask", "0x10", "-mode", "0o7", "-count", "0b11"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value, ok := mask.Unwrap(); !ok || value != 16 {
+		t.Errorf("expected mask to be Some(16), but got %d, %t", value, ok)
+	}
+	if value, ok := mode.Unwrap(); !ok || value != 7 {
+		t.Errorf("expected mode to be Some(7), but got %d, %t", value, ok)
+	}
+	if value, ok := count.Unwrap(); !ok || value != 3 {
+		t.Errorf("expected count to be Some(3), but got %d, %t", value, ok)
+	}
+}
@@ -0,0 +1,57 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String returns Some(x) for Some, with x formatted as by %v, and None for None.
+func (m *maybe[T]) String() string {
+	return fmt.Sprint(m)
+}
+
+// GoString returns a Go expression that creates the Maybe, as used by %#v.
+func (m *maybe[T]) GoString() string {
+	if !m.isSome {
+		return fmt.Sprintf("maybe.None[%v]()", reflect.TypeFor[T]())
+	}
+
+	return fmt.Sprintf("maybe.Some[%v](%#v)", reflect.TypeFor[T](), m.value)
+}
+
+// Format implements fmt.Formatter. Some is printed as Some(x), with the verb
+// and flags applied to x, and None is printed as None.
+func (m *maybe[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, m.value, m.isSome, m.GoString)
+}
+
+// String returns Some(x) for Some, with x formatted as by %v, and None for None.
+func (o Option[T]) String() string {
+	return fmt.Sprint(o)
+}
+
+// GoString returns a Go expression that creates the Option, as used by %#v.
+func (o Option[T]) GoString() string {
+	if !o.isSome {
+		return fmt.Sprintf("maybe.NoneOption[%v]()", reflect.TypeFor[T]())
+	}
+
+	return fmt.Sprintf("maybe.SomeOption[%v](%#v)", reflect.TypeFor[T](), o.value)
+}
+
+// Format implements fmt.Formatter. Some is printed as Some(x), with the verb
+// and flags applied to x, and None is printed as None.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, o.value, o.isSome, o.GoString)
+}
+
+func format[T any](f fmt.State, verb rune, value T, isSome bool, goString func() string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, goString())
+	case !isSome:
+		fmt.Fprint(f, "None")
+	default:
+		fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", value)
+	}
+}
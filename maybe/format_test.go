@@ -0,0 +1,62 @@
+package maybe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sjpeterson/typical/tuples"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		format   string
+		value    any
+		expected string
+	}{
+		{"%v", Some(42), "Some(42)"},
+		{"%v", None[int](), "None"},
+		{"%s", Some("test"), "Some(test)"},
+		{"%q", Some("test"), `Some("test")`},
+		{"%x", Some(255), "Some(ff)"},
+		{"%05d", Some(42), "Some(00042)"},
+		{"%.2f", Some(3.14159), "Some(3.14)"},
+		{"%q", None[string](), "None"},
+		{"%#v", Some(42), "maybe.Some[int](42)"},
+		{"%#v", Some("test"), `maybe.Some[string]("test")`},
+		{"%#v", None[string](), "maybe.None[string]()"},
+		{"%v", SomeOption(42), "Some(42)"},
+		{"%v", NoneOption[int](), "None"},
+		{"%q", SomeOption("test"), `Some("test")`},
+		{"%#v", SomeOption(42), "maybe.SomeOption[int](42)"},
+		{"%#v", NoneOption[int](), "maybe.NoneOption[int]()"},
+		{"%v", Some(Some(1)), "Some(Some(1))"},
+		{"%v", []Maybe[int]{Some(1), None[int]()}, "[Some(1) None]"},
+		{"%v", map[string]Maybe[int]{"a": Some(1)}, "map[a:Some(1)]"},
+		{"%v", tuples.NewPair(Some(1), None[string]()), "{Some(1) None}"},
+		{"%+v", tuples.NewPair(Some(1), NoneOption[string]()), "{First:Some(1) Second:None}"},
+	}
+
+	for _, tc := range testCases {
+		if formatted := fmt.Sprintf(tc.format, tc.value); formatted != tc.expected {
+			t.Errorf("expected formatting with %q to give %q, but got %q", tc.format, tc.expected, formatted)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	testCases := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{Some(42).(fmt.Stringer), "Some(42)"},
+		{None[int]().(fmt.Stringer), "None"},
+		{SomeOption("test"), "Some(test)"},
+		{NoneOption[string](), "None"},
+	}
+
+	for _, tc := range testCases {
+		if s := tc.value.String(); s != tc.expected {
+			t.Errorf("expected %q, but got %q", tc.expected, s)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// GobEncode encodes the Maybe for encoding/gob.
+func (m *maybe[T]) GobEncode() ([]byte, error) {
+	return gobEncode(m.value, m.isSome)
+}
+
+// GobDecode decodes a Maybe encoded by GobEncode.
+func (m *maybe[T]) GobDecode(data []byte) error {
+	return gobDecode(data, &m.value, &m.isSome)
+}
+
+// GobEncode encodes the Option for encoding/gob.
+func (o Option[T]) GobEncode() ([]byte, error) {
+	return gobEncode(o.value, o.isSome)
+}
+
+// GobDecode decodes an Option encoded by GobEncode.
+func (o *Option[T]) GobDecode(data []byte) error {
+	return gobDecode(data, &o.value, &o.isSome)
+}
+
+func gobEncode[T any](value T, isSome bool) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(isSome); err != nil {
+		return nil, err
+	}
+	if isSome {
+		if err := enc.Encode(&value); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func gobDecode[T any](data []byte, value *T, isSome *bool) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+
+	var decodedIsSome bool
+	if err := dec.Decode(&decodedIsSome); err != nil {
+		return err
+	}
+
+	var decoded T
+	if decodedIsSome {
+		if err := dec.Decode(&decoded); err != nil {
+			return err
+		}
+	}
+	*value, *isSome = decoded, decodedIsSome
+
+	return nil
+}
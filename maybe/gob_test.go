@@ -0,0 +1,53 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+type gobTestStruct struct {
+	Name Option[string]
+	Age  Option[int]
+	Tags Option[[]string]
+}
+
+func TestOption_GobRoundTrip(t *testing.T) {
+	testCases := []gobTestStruct{
+		{SomeOption("Alice"), SomeOption(0), SomeOption([]string{"a", "b"})},
+		{SomeOption(""), NoneOption[int](), NoneOption[[]string]()},
+		{},
+	}
+
+	for _, original := range testCases {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(original); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var decoded gobTestStruct
+		if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !original.Name.Equal(decoded.Name) || !original.Age.Equal(decoded.Age) || !original.Tags.Equal(decoded.Tags) {
+			t.Errorf("expected %v, but got %v", original, decoded)
+		}
+	}
+}
+
+func TestMaybe_GobRoundTrip(t *testing.T) {
+	for _, original := range []Maybe[int]{Some(42), Some(0), None[int]()} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(original); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		decoded := None[int]()
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !Equal(decoded, original) {
+			t.Errorf("expected %v, but got %v", original, decoded)
+		}
+	}
+}
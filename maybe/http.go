@@ -0,0 +1,84 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// defaultMaxMemory is the amount of a multipart form that is kept in memory,
+// the same as used by (*http.Request).FormValue.
+const defaultMaxMemory = 32 << 20
+
+// Query returns the first value of a URL query parameter, parsed with the
+// provided function. A missing parameter gives None, while a parameter that
+// is present but can't be parsed gives an error.
+func Query[T any](r *http.Request, key string, parse func(string) (T, error)) (Maybe[T], error) {
+	values, ok := r.URL.Query()[key]
+
+	return parseRequestValue("query parameter", key, values, ok, parse)
+}
+
+// Header returns the first value of a request header, parsed with the
+// provided function. A missing header gives None, while a header that is
+// present but can't be parsed gives an error.
+func Header[T any](r *http.Request, key string, parse func(string) (T, error)) (Maybe[T], error) {
+	values, ok := r.Header[http.CanonicalHeaderKey(key)]
+
+	return parseRequestValue("header", key, values, ok, parse)
+}
+
+// FormValue returns the first value of a form field, from either the request
+// body or the URL query, parsed with the provided function. A missing field
+// gives None, while a field that is present but can't be parsed, or a form
+// that can't be parsed at all, gives an error.
+func FormValue[T any](r *http.Request, key string, parse func(string) (T, error)) (Maybe[T], error) {
+	if err := parseForm(r); err != nil {
+		return None[T](), fmt.Errorf("maybe: parsing form: %w", err)
+	}
+	values, ok := r.Form[key]
+
+	return parseRequestValue("form value", key, values, ok, parse)
+}
+
+// PathValue returns the value of a path wildcard, parsed with the provided
+// function. A wildcard that is missing or matched nothing gives None, while
+// one that can't be parsed gives an error.
+func PathValue[T any](r *http.Request, name string, parse func(string) (T, error)) (Maybe[T], error) {
+	value := r.PathValue(name)
+
+	return parseRequestValue("path value", name, []string{value}, value != "", parse)
+}
+
+// parseForm parses the form of a request like (*http.Request).FormValue does,
+// but reports errors instead of ignoring them.
+func parseForm(r *http.Request) error {
+	if r.Form == nil {
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+	}
+	if r.MultipartForm == nil {
+		// ParseMultipartForm returns ErrNotMultipart for other content types,
+		// whose bodies ParseForm has already handled.
+		err := r.ParseMultipartForm(defaultMaxMemory)
+		if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseRequestValue[T any](kind, key string, values []string, ok bool, parse func(string) (T, error)) (Maybe[T], error) {
+	if !ok || len(values) == 0 {
+		return None[T](), nil
+	}
+
+	value, err := parse(values[0])
+	if err != nil {
+		return None[T](), fmt.Errorf("maybe: parsing %s %s: %w", kind, key, err)
+	}
+
+	return Some(value), nil
+}
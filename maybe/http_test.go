@@ -0,0 +1,159 @@
+package maybe
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/?page=2&empty=&bad=two", nil)
+
+	page, err := Query(r, "page", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(page, Some(2)) {
+		t.Errorf("expected Some(2), but got %v", page)
+	}
+
+	missing, err := Query(r, "missing", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missing.IsSome() {
+		t.Error("expected missing query parameter to be None")
+	}
+
+	if _, err := Query(r, "empty", strconv.Atoi); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error for an empty query parameter, but got %v", err)
+	}
+	if _, err := Query(r, "bad", strconv.Atoi); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error for a malformed query parameter, but got %v", err)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Retry-Count", "3")
+	r.Header.Set("X-Bad", "three")
+
+	retries, err := Header(r, "x-retry-count", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(retries, Some(3)) {
+		t.Errorf("expected Some(3), but got %v", retries)
+	}
+
+	missing, err := Header(r, "X-Missing", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missing.IsSome() {
+		t.Error("expected missing header to be None")
+	}
+
+	if _, err := Header(r, "X-Bad", strconv.Atoi); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error for a malformed header, but got %v", err)
+	}
+}
+
+func TestFormValue(t *testing.T) {
+	body := url.Values{"subscribe": {"true"}, "bad": {"maybe"}}.Encode()
+	r := httptest.NewRequest(http.MethodPost, "/?source=query", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	subscribe, err := FormValue(r, "subscribe", strconv.ParseBool)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(subscribe, Some(true)) {
+		t.Errorf("expected Some(true), but got %v", subscribe)
+	}
+
+	source, err := FormValue(r, "source", func(s string) (string, error) { return s, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(source, Some("query")) {
+		t.Errorf("expected Some(\"query\"), but got %v", source)
+	}
+
+	missing, err := FormValue(r, "missing", strconv.ParseBool)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missing.IsSome() {
+		t.Error("expected missing form value to be None")
+	}
+
+	if _, err := FormValue(r, "bad", strconv.ParseBool); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error for a malformed form value, but got %v", err)
+	}
+}
+
+func TestPathValue(t *testing.T) {
+	var (
+		id     Maybe[int]
+		rest   Maybe[string]
+		idErr  error
+		served bool
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/items/{id}/{rest...}", func(_ http.ResponseWriter, r *http.Request) {
+		served = true
+		id, idErr = PathValue(r, "id", strconv.Atoi)
+		rest, _ = PathValue(r, "rest", func(s string) (string, error) { return s, nil })
+	})
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/7/", nil))
+	if !served {
+		t.Fatal("handler was not called")
+	}
+	if idErr != nil {
+		t.Fatalf("unexpected error: %v", idErr)
+	}
+	if !Equal(id, Some(7)) {
+		t.Errorf("expected Some(7), but got %v", id)
+	}
+	if rest.IsSome() {
+		t.Errorf("expected empty wildcard to be None, but got %v", rest)
+	}
+
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/seven/a/b", nil))
+	if !errors.Is(idErr, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error for a malformed path value, but got %v", idErr)
+	}
+	if !Equal(rest, Some("a/b")) {
+		t.Errorf("expected Some(\"a/b\"), but got %v", rest)
+	}
+
+	unmatched, err := PathValue(httptest.NewRequest(http.MethodGet, "/", nil), "id", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unmatched.IsSome() {
+		t.Error("expected path value of an unmatched request to be None")
+	}
+}
+
+func TestFormValue_MalformedBody(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("subscribe=%zz"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if _, err := FormValue(r, "subscribe", strconv.ParseBool); err == nil {
+		t.Error("expected an error for a malformed form body")
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("not multipart"))
+	r.Header.Set("Content-Type", "multipart/form-data; boundary=xyz")
+
+	if _, err := FormValue(r, "subscribe", strconv.ParseBool); err == nil {
+		t.Error("expected an error for a malformed multipart body")
+	}
+}
@@ -0,0 +1,49 @@
+package maybe
+
+import (
+	"github.com/sjpeterson/typical/either"
+	"github.com/sjpeterson/typical/tuples"
+)
+
+// OkOr converts a Maybe to an Either, with the value of Some on the right and
+// the provided value on the left for None.
+func OkOr[L, T any](m Maybe[T], left L) either.Either[L, T] {
+	if value, ok := m.Unwrap(); ok {
+		return either.Right[L](value)
+	}
+
+	return either.Left[L, T](left)
+}
+
+// FromLeft returns Some with the left value of an Either, or None if it is Right.
+func FromLeft[A, B any](e either.Either[A, B]) Maybe[A] {
+	return FromOk(e.UnwrapLeft())
+}
+
+// FromRight returns Some with the right value of an Either, or None if it is Left.
+func FromRight[A, B any](e either.Either[A, B]) Maybe[B] {
+	return FromOk(e.UnwrapRight())
+}
+
+// Zip returns Some with a Pair of the values of two Maybes if both are Some,
+// and None otherwise.
+func Zip[A, B any](a Maybe[A], b Maybe[B]) Maybe[tuples.Pair[A, B]] {
+	aValue, aOk := a.Unwrap()
+	bValue, bOk := b.Unwrap()
+	if !aOk || !bOk {
+		return None[tuples.Pair[A, B]]()
+	}
+
+	return Some(tuples.NewPair(aValue, bValue))
+}
+
+// Unzip splits a Maybe of a Pair into a Pair of Maybes. Both are Some if the
+// Maybe is Some, and both are None otherwise.
+func Unzip[A, B any](m Maybe[tuples.Pair[A, B]]) (Maybe[A], Maybe[B]) {
+	pair, ok := m.Unwrap()
+	if !ok {
+		return None[A](), None[B]()
+	}
+
+	return Some(pair.First), Some(pair.Second)
+}
@@ -0,0 +1,62 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/sjpeterson/typical/either"
+	"github.com/sjpeterson/typical/tuples"
+)
+
+func TestOkOr(t *testing.T) {
+	right := OkOr(Some(6), "missing")
+	if value, ok := right.UnwrapRight(); !ok || value != 6 {
+		t.Errorf("expected OkOr(Some(6), \"missing\") to be Right(6), but got %d, %t", value, ok)
+	}
+
+	left := OkOr(None[int](), "missing")
+	if value, ok := left.UnwrapLeft(); !ok || value != "missing" {
+		t.Errorf("expected OkOr(None[int](), \"missing\") to be Left(\"missing\"), but got %q, %t", value, ok)
+	}
+}
+
+func TestFromLeft(t *testing.T) {
+	if !Equal(FromLeft(either.Left[int, string](6)), Some(6)) {
+		t.Error("expected FromLeft of Left(6) to be Some(6)")
+	}
+	if FromLeft(either.Right[int]("test")).IsSome() {
+		t.Error("expected FromLeft of Right to be None")
+	}
+}
+
+func TestFromRight(t *testing.T) {
+	if !Equal(FromRight(either.Right[int]("test")), Some("test")) {
+		t.Error("expected FromRight of Right(\"test\") to be Some(\"test\")")
+	}
+	if FromRight(either.Left[int, string](6)).IsSome() {
+		t.Error("expected FromRight of Left to be None")
+	}
+}
+
+func TestZip(t *testing.T) {
+	if !Equal(Zip(Some(6), Some("test")), Some(tuples.NewPair(6, "test"))) {
+		t.Error("expected Zip(Some(6), Some(\"test\")) to be Some(Pair(6, \"test\"))")
+	}
+	if Zip(Some(6), None[string]()).IsSome() {
+		t.Error("expected Zip(Some(6), None[string]()) to be None")
+	}
+	if Zip(None[int](), Some("test")).IsSome() {
+		t.Error("expected Zip(None[int](), Some(\"test\")) to be None")
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	first, second := Unzip(Some(tuples.NewPair(6, "test")))
+	if !Equal(first, Some(6)) || !Equal(second, Some("test")) {
+		t.Errorf("expected Some(6) and Some(\"test\"), but got %v and %v", first, second)
+	}
+
+	first, second = Unzip(None[tuples.Pair[int, string]]())
+	if first.IsSome() || second.IsSome() {
+		t.Errorf("expected None and None, but got %v and %v", first, second)
+	}
+}
@@ -0,0 +1,56 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON encodes Some as its value and None as null.
+func (m *maybe[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](m.value, m.isSome)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (m *maybe[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &m.value, &m.isSome)
+}
+
+// MarshalJSON encodes Some as its value and None as null.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](o.value, o.isSome)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some. A field
+// that is missing from the input is left untouched, i.e. None for a zero
+// Option.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &o.value, &o.isSome)
+}
+
+func marshalJSON[T any](value T, isSome bool) ([]byte, error) {
+	if !isSome {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(value)
+}
+
+func unmarshalJSON[T any](data []byte, value *T, isSome *bool) error {
+	var zero T
+
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*value, *isSome = zero, false
+
+		return nil
+	}
+
+	decoded := zero
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*value, *isSome = decoded, true
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package maybe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMaybe_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		value    Maybe[int]
+		expected string
+	}{
+		{Some(42), "42"},
+		{Some(0), "0"},
+		{None[int](), "null"},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("expected %s, but got %s", tc.expected, data)
+		}
+	}
+}
+
+func TestMaybe_UnmarshalJSON(t *testing.T) {
+	m := None[string]()
+	if err := json.Unmarshal([]byte(`"test"`), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := m.Unwrap(); !ok || value != "test" {
+		t.Errorf("expected Some(\"test\"), but got %q, %t", value, ok)
+	}
+
+	if err := json.Unmarshal([]byte("null"), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.IsSome() {
+		t.Error("decoding null gave Some")
+	}
+}
+
+type jsonTestStruct struct {
+	Name    Option[string] `json:"name"`
+	Age     Option[int]    `json:"age"`
+	Comment Option[string] `json:"comment,omitzero"`
+}
+
+func TestOption_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		value    jsonTestStruct
+		expected string
+	}{
+		{
+			jsonTestStruct{OptionOf(Some("Alice")), OptionOf(Some(0)), OptionOf(Some("hi"))},
+			`{"name":"Alice","age":0,"comment":"hi"}`,
+		},
+		{
+			jsonTestStruct{},
+			`{"name":null,"age":null}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("expected %s, but got %s", tc.expected, data)
+		}
+	}
+}
+
+func TestOption_UnmarshalJSON(t *testing.T) {
+	var decoded jsonTestStruct
+	if err := json.Unmarshal([]byte(`{"name":"Bob","age":null}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value, ok := decoded.Name.Unwrap(); !ok || value != "Bob" {
+		t.Errorf("expected name to be Some(\"Bob\"), but got %q, %t", value, ok)
+	}
+	if decoded.Age.IsSome() {
+		t.Error("expected null age to be None")
+	}
+	if decoded.Comment.IsSome() {
+		t.Error("expected missing comment to be None")
+	}
+}
+
+func TestOption_UnmarshalJSONError(t *testing.T) {
+	var decoded jsonTestStruct
+	if err := json.Unmarshal([]byte(`{"age":"old"}`), &decoded); err == nil {
+		t.Error("expected an error when decoding a string into Option[int]")
+	}
+	if decoded.Age.IsSome() {
+		t.Error("expected age to remain None after a failed decode")
+	}
+}
@@ -0,0 +1,159 @@
+package maybe
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+type lazy[T any] struct {
+	compute func() Option[T]
+	decoded atomic.Pointer[Option[T]]
+}
+
+// Lazy creates a Maybe whose value is computed by the provided function the
+// first time it is needed. The function is called at most once, even under
+// concurrent use, and its result is cached for later calls. Apart from that,
+// it behaves like a Maybe created by Some or None, e.g. when it is formatted
+// or encoded. Decoding into it replaces the value without calling the function.
+func Lazy[T any](f func() (T, bool)) Maybe[T] {
+	return &lazy[T]{compute: sync.OnceValue(func() Option[T] {
+		value, ok := f()
+
+		return Option[T]{value, ok}
+	})}
+}
+
+// IsSome returns true if the Maybe has a valid value.
+func (l *lazy[T]) IsSome() bool {
+	return l.get().IsSome()
+}
+
+// IsNone returns true if the Maybe does not have a valid value.
+func (l *lazy[T]) IsNone() bool {
+	return l.get().IsNone()
+}
+
+// Unwrap returns the value (if any) and a bool indicating whether or not it is valid.
+func (l *lazy[T]) Unwrap() (T, bool) {
+	return l.get().Unwrap()
+}
+
+// String returns Some(x) for Some, with x formatted as by %v, and None for None.
+func (l *lazy[T]) String() string {
+	return l.get().String()
+}
+
+// GoString returns a Go expression that creates a Maybe with the same value,
+// as used by %#v.
+func (l *lazy[T]) GoString() string {
+	o := l.get()
+
+	return (&maybe[T]{o.value, o.isSome}).GoString()
+}
+
+// Format implements fmt.Formatter. Some is printed as Some(x), with the verb
+// and flags applied to x, and None is printed as None.
+func (l *lazy[T]) Format(f fmt.State, verb rune) {
+	o := l.get()
+	format(f, verb, o.value, o.isSome, l.GoString)
+}
+
+// Equal returns true if the other Maybe is also None, or if both are Some
+// with deeply equal values. It is picked up by go-cmp.
+func (l *lazy[T]) Equal(other Maybe[T]) bool {
+	return l.get().Equal(other)
+}
+
+// MarshalJSON encodes Some as its value and None as null.
+func (l *lazy[T]) MarshalJSON() ([]byte, error) {
+	return l.get().MarshalJSON()
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (l *lazy[T]) UnmarshalJSON(data []byte) error {
+	return l.decode(func(o *Option[T]) error { return o.UnmarshalJSON(data) })
+}
+
+// MarshalText encodes Some as the text of its value and None as empty text.
+func (l *lazy[T]) MarshalText() ([]byte, error) {
+	return l.get().MarshalText()
+}
+
+// UnmarshalText decodes empty text as None and any other text as Some.
+func (l *lazy[T]) UnmarshalText(text []byte) error {
+	return l.decode(func(o *Option[T]) error { return o.UnmarshalText(text) })
+}
+
+// MarshalXML encodes Some as an element holding its value, and leaves the
+// element out for None.
+func (l *lazy[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return l.get().MarshalXML(e, start)
+}
+
+// UnmarshalXML decodes an element as Some.
+func (l *lazy[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return l.decode(func(o *Option[T]) error { return o.UnmarshalXML(d, start) })
+}
+
+// MarshalXMLAttr encodes Some as an attribute holding the text of its value,
+// and leaves the attribute out for None.
+func (l *lazy[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return l.get().MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr decodes an attribute as Some.
+func (l *lazy[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return l.decode(func(o *Option[T]) error { return o.UnmarshalXMLAttr(attr) })
+}
+
+// GobEncode encodes the Maybe for encoding/gob.
+func (l *lazy[T]) GobEncode() ([]byte, error) {
+	return l.get().GobEncode()
+}
+
+// GobDecode decodes a Maybe encoded by GobEncode.
+func (l *lazy[T]) GobDecode(data []byte) error {
+	return l.decode(func(o *Option[T]) error { return o.GobDecode(data) })
+}
+
+// Scan implements sql.Scanner. SQL NULL is scanned as None and any other
+// value as Some.
+func (l *lazy[T]) Scan(src any) error {
+	return l.decode(func(o *Option[T]) error { return o.Scan(src) })
+}
+
+// Value implements driver.Valuer. None is stored as SQL NULL.
+func (l *lazy[T]) Value() (driver.Value, error) {
+	return l.get().Value()
+}
+
+// LogValue implements slog.LogValuer. Some is logged as its value and None as null.
+func (l *lazy[T]) LogValue() slog.Value {
+	return l.get().LogValue()
+}
+
+// get returns the decoded value if the Maybe has been decoded into, and the
+// computed value otherwise.
+func (l *lazy[T]) get() Option[T] {
+	if decoded := l.decoded.Load(); decoded != nil {
+		return *decoded
+	}
+
+	return l.compute()
+}
+
+// decode decodes into a new value, and replaces the value of the Maybe with it
+// if decoding succeeds. The function passed to Lazy is not called.
+func (l *lazy[T]) decode(f func(*Option[T]) error) error {
+	var o Option[T]
+	if err := f(&o); err != nil {
+		return err
+	}
+	l.decoded.Store(&o)
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package maybe
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	m := Lazy(func() (int, bool) {
+		calls++
+
+		return 42, true
+	})
+
+	if calls != 0 {
+		t.Error("Lazy called the function before it was needed")
+	}
+
+	if !m.IsSome() || m.IsNone() {
+		t.Error("expected Lazy of a valid value to be Some")
+	}
+	if value, ok := m.Unwrap(); !ok || value != 42 {
+		t.Errorf("expected Some(42), but got %d, %t", value, ok)
+	}
+	if calls != 1 {
+		t.Errorf("expected the function to be called once, but it was called %d times", calls)
+	}
+}
+
+func TestLazy_None(t *testing.T) {
+	m := Lazy(func() (string, bool) { return "", false })
+
+	if m.IsSome() || !m.IsNone() {
+		t.Error("expected Lazy of an invalid value to be None")
+	}
+	if _, ok := m.Unwrap(); ok {
+		t.Error("unwrapping Lazy of an invalid value was ok")
+	}
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	var calls atomic.Int32
+	m := Lazy(func() (int, bool) {
+		calls.Add(1)
+
+		return 42, true
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, ok := m.Unwrap(); !ok || value != 42 {
+				t.Errorf("expected Some(42), but got %d, %t", value, ok)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected the function to be called once, but it was called %d times", n)
+	}
+}
+
+func TestLazy_Format(t *testing.T) {
+	testCases := []struct {
+		format   string
+		value    Maybe[int]
+		expected string
+	}{
+		{"%v", Lazy(func() (int, bool) { return 42, true }), "Some(42)"},
+		{"%x", Lazy(func() (int, bool) { return 255, true }), "Some(ff)"},
+		{"%v", Lazy(func() (int, bool) { return 0, false }), "None"},
+		{"%#v", Lazy(func() (int, bool) { return 42, true }), "maybe.Some[int](42)"},
+		{"%#v", Lazy(func() (int, bool) { return 0, false }), "maybe.None[int]()"},
+	}
+
+	for _, tc := range testCases {
+		if formatted := fmt.Sprintf(tc.format, tc.value); formatted != tc.expected {
+			t.Errorf("expected formatting with %q to give %q, but got %q", tc.format, tc.expected, formatted)
+		}
+	}
+}
+
+func TestLazy_JSON(t *testing.T) {
+	data, err := json.Marshal(map[string]Maybe[int]{
+		"some": Lazy(func() (int, bool) { return 42, true }),
+		"none": Lazy(func() (int, bool) { return 0, false }),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"none":null,"some":42}`; string(data) != expected {
+		t.Errorf("expected %s, but got %s", expected, data)
+	}
+
+	m := Lazy(func() (int, bool) { return 0, false })
+	if err := json.Unmarshal([]byte("7"), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(m, Some(7)) {
+		t.Errorf("expected decoding 7 to give Some(7), but got %v", m)
+	}
+}
+
+func TestLazy_Equal(t *testing.T) {
+	m := Lazy(func() (string, bool) { return "test", true })
+	equaler := m.(interface{ Equal(Maybe[string]) bool })
+
+	if !equaler.Equal(Some("test")) {
+		t.Error("expected Lazy of \"test\" to equal Some(\"test\")")
+	}
+	if equaler.Equal(None[string]()) {
+		t.Error("expected Lazy of \"test\" to not equal None")
+	}
+}
+
+func TestLazy_DecodingDoesNotCallFunction(t *testing.T) {
+	calls := 0
+	newLazy := func() Maybe[int] {
+		return Lazy(func() (int, bool) {
+			calls++
+
+			return 0, false
+		})
+	}
+
+	decoders := map[string]func(Maybe[int]) error{
+		"json": func(m Maybe[int]) error { return json.Unmarshal([]byte("7"), m) },
+		"text": func(m Maybe[int]) error { return m.(encoding.TextUnmarshaler).UnmarshalText([]byte("7")) },
+		"xml":  func(m Maybe[int]) error { return xml.Unmarshal([]byte("<value>7</value>"), m) },
+		"scan": func(m Maybe[int]) error { return m.(sql.Scanner).Scan(int64(7)) },
+		"gob": func(m Maybe[int]) error {
+			data, err := SomeOption(7).GobEncode()
+			if err != nil {
+				return err
+			}
+
+			return m.(gob.GobDecoder).GobDecode(data)
+		},
+	}
+
+	for name, decode := range decoders {
+		m := newLazy()
+		if err := decode(m); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if !Equal(m, Some(7)) {
+			t.Errorf("%s: expected decoding to give Some(7), but got %v", name, m)
+		}
+		if calls != 0 {
+			t.Errorf("%s: decoding called the function %d times", name, calls)
+		}
+	}
+}
+
+func TestLazy_ConcurrentDecode(t *testing.T) {
+	m := Lazy(func() (int, bool) { return 1, true })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := json.Unmarshal([]byte("2"), m); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if value, ok := m.Unwrap(); !ok || (value != 1 && value != 2) {
+				t.Errorf("expected Some(1) or Some(2), but got %d, %t", value, ok)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !Equal(m, Some(2)) {
+		t.Errorf("expected Some(2) after decoding, but got %v", m)
+	}
+}
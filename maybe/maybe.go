@@ -1,5 +1,10 @@
 package maybe
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // Maybe[T] is a value of type T or no value.
 type Maybe[T any] interface {
 	IsSome() bool
@@ -38,3 +43,90 @@ func (m *maybe[T]) IsNone() bool {
 func (m *maybe[T]) Unwrap() (T, bool) {
 	return m.value, m.isSome
 }
+
+// Match calls onSome with the value of the Maybe if it is Some, and onNone if
+// it is None.
+func Match[T any](m Maybe[T], onSome func(T), onNone func()) {
+	if value, ok := m.Unwrap(); ok {
+		onSome(value)
+	} else {
+		onNone()
+	}
+}
+
+// Fold returns the result of calling onSome with the value of the Maybe if it
+// is Some, and the result of calling onNone if it is None. Exactly one of the
+// functions is called.
+func Fold[T, U any](m Maybe[T], onNone func() U, onSome func(T) U) U {
+	if value, ok := m.Unwrap(); ok {
+		return onSome(value)
+	}
+
+	return onNone()
+}
+
+// UnwrapOr returns the value of the Maybe, or the provided default for None.
+func UnwrapOr[T any](m Maybe[T], def T) T {
+	if value, ok := m.Unwrap(); ok {
+		return value
+	}
+
+	return def
+}
+
+// UnwrapOrElse returns the value of the Maybe, or the result of calling the
+// provided function for None. The function is only called for None.
+func UnwrapOrElse[T any](m Maybe[T], f func() T) T {
+	if value, ok := m.Unwrap(); ok {
+		return value
+	}
+
+	return f()
+}
+
+// UnwrapOrZero returns the value of the Maybe, or the zero value of T for None.
+func UnwrapOrZero[T any](m Maybe[T]) T {
+	value, _ := m.Unwrap()
+
+	return value
+}
+
+// Must returns the value of the Maybe, and panics for None.
+func Must[T any](m Maybe[T]) T {
+	value, ok := m.Unwrap()
+	if !ok {
+		panic(fmt.Sprintf("maybe: Must called on None[%v]", reflect.TypeFor[T]()))
+	}
+
+	return value
+}
+
+// Map applies a function to the value of a Maybe, if any. None is returned as
+// None without calling the function.
+func Map[T, U any](m Maybe[T], f func(T) U) Maybe[U] {
+	if value, ok := m.Unwrap(); ok {
+		return Some(f(value))
+	}
+
+	return None[U]()
+}
+
+// FlatMap applies a function returning a Maybe to the value of a Maybe, if
+// any. None is returned as None without calling the function.
+func FlatMap[T, U any](m Maybe[T], f func(T) Maybe[U]) Maybe[U] {
+	if value, ok := m.Unwrap(); ok {
+		return f(value)
+	}
+
+	return None[U]()
+}
+
+// Filter returns the Maybe if it has a value that satisfies the predicate, and
+// None otherwise. The predicate is not called for None.
+func Filter[T any](m Maybe[T], predicate func(T) bool) Maybe[T] {
+	if value, ok := m.Unwrap(); ok && predicate(value) {
+		return m
+	}
+
+	return None[T]()
+}
@@ -1,6 +1,7 @@
 package maybe
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -91,3 +92,195 @@ func TestSome_Unwrap(t *testing.T) {
 		t.Error("unwrapping None[string]() was ok")
 	}
 }
+
+func TestMap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+
+	if value, ok := Map(Some(21), double).Unwrap(); !ok || value != 42 {
+		t.Errorf("expected Map(Some(21), double) to be Some(42), but got %d, %t", value, ok)
+	}
+
+	called := false
+	mapped := Map(None[int](), func(n int) string {
+		called = true
+
+		return "called"
+	})
+	if mapped.IsSome() {
+		t.Error("Map(None[int](), f).IsSome() is true")
+	}
+	if called {
+		t.Error("Map called the function for None")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	positive := func(n int) Maybe[int] {
+		if n > 0 {
+			return Some(n)
+		}
+
+		return None[int]()
+	}
+
+	if value, ok := FlatMap(Some(3), positive).Unwrap(); !ok || value != 3 {
+		t.Errorf("expected FlatMap(Some(3), positive) to be Some(3), but got %d, %t", value, ok)
+	}
+
+	if FlatMap(Some(-3), positive).IsSome() {
+		t.Error("FlatMap(Some(-3), positive).IsSome() is true")
+	}
+
+	called := false
+	flatMapped := FlatMap(None[int](), func(n int) Maybe[int] {
+		called = true
+
+		return Some(n)
+	})
+	if flatMapped.IsSome() {
+		t.Error("FlatMap(None[int](), f).IsSome() is true")
+	}
+	if called {
+		t.Error("FlatMap called the function for None")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	if value, ok := Filter(Some(4), isEven).Unwrap(); !ok || value != 4 {
+		t.Errorf("expected Filter(Some(4), isEven) to be Some(4), but got %d, %t", value, ok)
+	}
+
+	if Filter(Some(5), isEven).IsSome() {
+		t.Error("Filter(Some(5), isEven).IsSome() is true")
+	}
+
+	called := false
+	filtered := Filter(None[int](), func(n int) bool {
+		called = true
+
+		return true
+	})
+	if filtered.IsSome() {
+		t.Error("Filter(None[int](), predicate).IsSome() is true")
+	}
+	if called {
+		t.Error("Filter called the predicate for None")
+	}
+}
+
+func TestUnwrapOr(t *testing.T) {
+	if value := UnwrapOr(Some(6), 9); value != 6 {
+		t.Errorf("expected UnwrapOr(Some(6), 9) to be 6, but got %d", value)
+	}
+	if value := UnwrapOr(None[int](), 9); value != 9 {
+		t.Errorf("expected UnwrapOr(None[int](), 9) to be 9, but got %d", value)
+	}
+}
+
+func TestUnwrapOrElse(t *testing.T) {
+	calls := 0
+	fallback := func() string {
+		calls++
+
+		return "fallback"
+	}
+
+	if value := UnwrapOrElse(Some("test"), fallback); value != "test" {
+		t.Errorf("expected UnwrapOrElse(Some(\"test\"), fallback) to be \"test\", but got %q", value)
+	}
+	if calls != 0 {
+		t.Error("UnwrapOrElse called the function for Some")
+	}
+
+	if value := UnwrapOrElse(None[string](), fallback); value != "fallback" {
+		t.Errorf("expected UnwrapOrElse(None[string](), fallback) to be \"fallback\", but got %q", value)
+	}
+	if calls != 1 {
+		t.Errorf("expected UnwrapOrElse to call the function once for None, but it was called %d times", calls)
+	}
+}
+
+func TestUnwrapOrZero(t *testing.T) {
+	if value := UnwrapOrZero(Some(6)); value != 6 {
+		t.Errorf("expected UnwrapOrZero(Some(6)) to be 6, but got %d", value)
+	}
+	if value := UnwrapOrZero(None[int]()); value != 0 {
+		t.Errorf("expected UnwrapOrZero(None[int]()) to be 0, but got %d", value)
+	}
+}
+
+func TestMust(t *testing.T) {
+	if value := Must(Some(6)); value != 6 {
+		t.Errorf("expected Must(Some(6)) to be 6, but got %d", value)
+	}
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			t.Fatal("expected Must(None[int]()) to panic")
+		}
+		if message := recovered.(string); message != "maybe: Must called on None[int]" {
+			t.Errorf("unexpected panic message %q", message)
+		}
+	}()
+	Must(None[int]())
+}
+
+func TestMatch(t *testing.T) {
+	var someValue int
+	someCalls, noneCalls := 0, 0
+	onSome := func(value int) {
+		someCalls++
+		someValue = value
+	}
+	onNone := func() { noneCalls++ }
+
+	Match(Some(6), onSome, onNone)
+	if someCalls != 1 || noneCalls != 0 {
+		t.Errorf("expected Match(Some(6), ...) to call onSome only, but got %d onSome and %d onNone calls", someCalls, noneCalls)
+	}
+	if someValue != 6 {
+		t.Errorf("expected onSome to be called with 6, but got %d", someValue)
+	}
+
+	someCalls, noneCalls = 0, 0
+	Match(None[int](), onSome, onNone)
+	if someCalls != 0 || noneCalls != 1 {
+		t.Errorf("expected Match(None[int](), ...) to call onNone only, but got %d onSome and %d onNone calls", someCalls, noneCalls)
+	}
+}
+
+func TestFold(t *testing.T) {
+	someCalls, noneCalls := 0, 0
+	describe := func(m Maybe[int]) string {
+		return Fold(m,
+			func() string {
+				noneCalls++
+
+				return "nothing"
+			},
+			func(value int) string {
+				someCalls++
+
+				return fmt.Sprintf("got %d", value)
+			},
+		)
+	}
+
+	if description := describe(Some(6)); description != "got 6" {
+		t.Errorf("expected \"got 6\", but got %q", description)
+	}
+	if someCalls != 1 || noneCalls != 0 {
+		t.Errorf("expected Fold of Some to call onSome only, but got %d onSome and %d onNone calls", someCalls, noneCalls)
+	}
+
+	someCalls, noneCalls = 0, 0
+	if description := describe(None[int]()); description != "nothing" {
+		t.Errorf("expected \"nothing\", but got %q", description)
+	}
+	if someCalls != 0 || noneCalls != 1 {
+		t.Errorf("expected Fold of None to call onNone only, but got %d onSome and %d onNone calls", someCalls, noneCalls)
+	}
+}
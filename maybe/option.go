@@ -0,0 +1,54 @@
+package maybe
+
+// Option[T] is a Maybe that is a plain value rather than an interface. Its zero
+// value is None, which makes it suitable for struct fields that are decoded
+// from external data, and creating one does not allocate.
+type Option[T any] struct {
+	value  T
+	isSome bool
+}
+
+var _ Maybe[int] = Option[int]{}
+
+// SomeOption creates an Option with a value.
+func SomeOption[T any](value T) Option[T] {
+	return Option[T]{value, true}
+}
+
+// NoneOption creates an Option without a value. It is the same as the zero value.
+func NoneOption[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf converts a Maybe to an Option.
+func OptionOf[T any](m Maybe[T]) Option[T] {
+	value, ok := m.Unwrap()
+
+	return Option[T]{value, ok}
+}
+
+// Maybe converts the Option to a Maybe.
+func (o Option[T]) Maybe() Maybe[T] {
+	return &maybe[T]{o.value, o.isSome}
+}
+
+// IsSome returns true if the Option has a valid value.
+func (o Option[T]) IsSome() bool {
+	return o.isSome
+}
+
+// IsNone returns true if the Option does not have a valid value.
+func (o Option[T]) IsNone() bool {
+	return !o.isSome
+}
+
+// Unwrap returns the value (if any) and a bool indicating whether or not it is valid.
+func (o Option[T]) Unwrap() (T, bool) {
+	return o.value, o.isSome
+}
+
+// IsZero returns true if the Option is None. It allows struct fields of type
+// Option to be left out of encoded data with the omitzero option (Go 1.24 and later).
+func (o Option[T]) IsZero() bool {
+	return !o.isSome
+}
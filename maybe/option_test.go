@@ -0,0 +1,110 @@
+package maybe
+
+import "testing"
+
+func TestOption_ZeroValueIsNone(t *testing.T) {
+	var zero Option[int]
+
+	if zero.IsSome() {
+		t.Error("Option[int]{}.IsSome() is true")
+	}
+	if !zero.IsNone() {
+		t.Error("Option[int]{}.IsNone() is false")
+	}
+	if _, ok := zero.Unwrap(); ok {
+		t.Error("unwrapping Option[int]{} was ok")
+	}
+}
+
+func TestOptionOf(t *testing.T) {
+	someOption := OptionOf(Some(6))
+	noOption := OptionOf(None[int]())
+
+	if value, ok := someOption.Unwrap(); !ok || value != 6 {
+		t.Errorf("expected OptionOf(Some(6)) to unwrap to 6, true, but got %d, %t", value, ok)
+	}
+	if noOption.IsSome() {
+		t.Error("OptionOf(None[int]()).IsSome() is true")
+	}
+}
+
+func TestOption_Maybe(t *testing.T) {
+	if value, ok := OptionOf(Some("test")).Maybe().Unwrap(); !ok || value != "test" {
+		t.Errorf("expected Maybe() of Some(\"test\") to unwrap to \"test\", true, but got %q, %t", value, ok)
+	}
+	if (Option[string]{}).Maybe().IsSome() {
+		t.Error("Option[string]{}.Maybe().IsSome() is true")
+	}
+}
+
+func TestSomeOption(t *testing.T) {
+	if value, ok := SomeOption(6).Unwrap(); !ok || value != 6 {
+		t.Errorf("expected SomeOption(6) to unwrap to 6, true, but got %d, %t", value, ok)
+	}
+	if NoneOption[int]().IsSome() {
+		t.Error("NoneOption[int]().IsSome() is true")
+	}
+	if NoneOption[int]() != (Option[int]{}) {
+		t.Error("NoneOption[int]() is not the zero value")
+	}
+}
+
+func TestOption_DoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		o := SomeOption(42)
+		if o.IsSome() {
+			o = NoneOption[int]()
+		}
+		optionSink, _ = o.Unwrap()
+	})
+
+	if allocs != 0 {
+		t.Errorf("expected no allocations, but got %v per run", allocs)
+	}
+}
+
+var (
+	maybeSink  Maybe[int]
+	optionSink int
+)
+
+func BenchmarkSome(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		maybeSink = Some(i)
+	}
+}
+
+func BenchmarkSomeOption(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		o := SomeOption(i)
+		optionSink, _ = o.Unwrap()
+	}
+}
+
+func BenchmarkMaybeStruct(b *testing.B) {
+	type record struct {
+		id    int
+		value Maybe[int]
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := record{id: i, value: Some(i)}
+		maybeSink = r.value
+	}
+}
+
+func BenchmarkOptionStruct(b *testing.B) {
+	type record struct {
+		id    int
+		value Option[int]
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r := record{id: i, value: SomeOption(i)}
+		optionSink, _ = r.value.Unwrap()
+	}
+}
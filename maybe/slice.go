@@ -0,0 +1,55 @@
+package maybe
+
+// Values returns the values of all Somes in a slice of Maybes, in order.
+func Values[T any](ms []Maybe[T]) []T {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		if value, ok := m.Unwrap(); ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// Sequence returns Some with the values of a slice of Maybes if all of them
+// are Some, and None if any of them is None.
+func Sequence[T any](ms []Maybe[T]) Maybe[[]T] {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		value, ok := m.Unwrap()
+		if !ok {
+			return None[[]T]()
+		}
+		values = append(values, value)
+	}
+
+	return Some(values)
+}
+
+// Traverse applies a function returning a Maybe to each element of a slice.
+// It returns Some with the results if all of them are Some, and None as soon
+// as one of them is None, without calling the function for the rest.
+func Traverse[T, U any](xs []T, f func(T) Maybe[U]) Maybe[[]U] {
+	values := make([]U, 0, len(xs))
+	for _, x := range xs {
+		value, ok := f(x).Unwrap()
+		if !ok {
+			return None[[]U]()
+		}
+		values = append(values, value)
+	}
+
+	return Some(values)
+}
+
+// Coalesce returns the first Maybe that is Some, or None if there is none.
+func Coalesce[T any](ms ...Maybe[T]) Maybe[T] {
+	for _, m := range ms {
+		if m.IsSome() {
+			return m
+		}
+	}
+
+	return None[T]()
+}
@@ -0,0 +1,67 @@
+package maybe
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	ms := []Maybe[int]{Some(1), None[int](), Some(3), None[int]()}
+
+	if values := Values(ms); !reflect.DeepEqual(values, []int{1, 3}) {
+		t.Errorf("expected [1 3], but got %v", values)
+	}
+	if values := Values[int](nil); values == nil || len(values) != 0 {
+		t.Errorf("expected an empty slice, but got %#v", values)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	values, ok := Sequence([]Maybe[int]{Some(1), Some(2), Some(3)}).Unwrap()
+	if !ok || !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("expected Some([1 2 3]), but got %v, %t", values, ok)
+	}
+
+	if Sequence([]Maybe[int]{Some(1), None[int](), Some(3)}).IsSome() {
+		t.Error("expected Sequence with a None to be None")
+	}
+
+	if values, ok := Sequence[int](nil).Unwrap(); !ok || len(values) != 0 {
+		t.Errorf("expected Sequence of no Maybes to be Some([]), but got %v, %t", values, ok)
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	ages := map[string]int{"alice": 31, "bob": 27}
+	calls := 0
+	lookupAge := func(name string) Maybe[int] {
+		calls++
+
+		return Lookup(ages, name)
+	}
+
+	values, ok := Traverse([]string{"alice", "bob"}, lookupAge).Unwrap()
+	if !ok || !reflect.DeepEqual(values, []int{31, 27}) {
+		t.Errorf("expected Some([31 27]), but got %v, %t", values, ok)
+	}
+
+	calls = 0
+	if Traverse([]string{"carol", "alice", "bob"}, lookupAge).IsSome() {
+		t.Error("expected Traverse with a missing name to be None")
+	}
+	if calls != 1 {
+		t.Errorf("expected Traverse to stop at the first None, but the function was called %d times", calls)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	if value, ok := Coalesce(None[int](), Some(2), Some(3)).Unwrap(); !ok || value != 2 {
+		t.Errorf("expected Some(2), but got %d, %t", value, ok)
+	}
+	if Coalesce(None[int](), None[int]()).IsSome() {
+		t.Error("expected Coalesce of only Nones to be None")
+	}
+	if Coalesce[int]().IsSome() {
+		t.Error("expected Coalesce of no Maybes to be None")
+	}
+}
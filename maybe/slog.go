@@ -0,0 +1,32 @@
+package maybe
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. Some is logged as its value and None as null.
+func (m *maybe[T]) LogValue() slog.Value {
+	return logValue(m.value, m.isSome)
+}
+
+// LogValue implements slog.LogValuer. Some is logged as its value and None as null.
+func (o Option[T]) LogValue() slog.Value {
+	return logValue(o.value, o.isSome)
+}
+
+// Attr returns an slog.Attr with the value of the Maybe for Some, and an empty
+// Attr, which handlers leave out, for None.
+func Attr[T any](key string, m Maybe[T]) slog.Attr {
+	value, ok := m.Unwrap()
+	if !ok {
+		return slog.Attr{}
+	}
+
+	return slog.Any(key, value)
+}
+
+func logValue[T any](value T, isSome bool) slog.Value {
+	if !isSome {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.AnyValue(value)
+}
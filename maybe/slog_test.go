@@ -0,0 +1,47 @@
+package maybe
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func logJSON(args ...any) string {
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == slog.LevelKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	})
+	slog.New(handler).Info("test", args...)
+
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValue(t *testing.T) {
+	logged := logJSON(
+		"some", Some(42),
+		"none", None[int](),
+		"someOption", SomeOption("test"),
+		"noneOption", NoneOption[string](),
+	)
+
+	expected := `{"msg":"test","some":42,"none":null,"someOption":"test","noneOption":null}`
+	if logged != expected {
+		t.Errorf("expected %s, but got %s", expected, logged)
+	}
+}
+
+func TestAttr(t *testing.T) {
+	logged := logJSON(Attr("some", Some(42)), Attr("none", None[int]()))
+
+	expected := `{"msg":"test","some":42}`
+	if logged != expected {
+		t.Errorf("expected %s, but got %s", expected, logged)
+	}
+}
@@ -0,0 +1,48 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner. SQL NULL is scanned as None and any other
+// value as Some, using the same conversions as (*sql.Rows).Scan.
+func (m *maybe[T]) Scan(src any) error {
+	return scan(src, &m.value, &m.isSome)
+}
+
+// Value implements driver.Valuer. None is stored as SQL NULL.
+func (m *maybe[T]) Value() (driver.Value, error) {
+	return value(m.value, m.isSome)
+}
+
+// Scan implements sql.Scanner. SQL NULL is scanned as None and any other
+// value as Some, using the same conversions as (*sql.Rows).Scan.
+func (o *Option[T]) Scan(src any) error {
+	return scan(src, &o.value, &o.isSome)
+}
+
+// Value implements driver.Valuer. None is stored as SQL NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	return value(o.value, o.isSome)
+}
+
+func scan[T any](src any, value *T, isSome *bool) error {
+	var scanned sql.Null[T]
+	if err := scanned.Scan(src); err != nil {
+		return err
+	}
+	*value, *isSome = scanned.V, scanned.Valid
+
+	return nil
+}
+
+// value converts the value to one of the types supported by drivers, such as
+// int64 for any integer type or the result of a driver.Valuer, or nil for None.
+func value[T any](v T, isSome bool) (driver.Value, error) {
+	if !isSome {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
@@ -0,0 +1,192 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal database/sql driver. Every query returns a single
+// column holding the values of the arguments it was given, one row per
+// argument, and every exec records its arguments.
+type fakeDriver struct {
+	execArgs []driver.Value
+}
+
+type fakeConn struct{ driver *fakeDriver }
+
+type fakeStmt struct{ driver *fakeDriver }
+
+type fakeRows struct {
+	values []driver.Value
+	next   int
+}
+
+func (d *fakeDriver) Open(_ string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+func (c *fakeConn) Prepare(_ string) (driver.Stmt, error) { return &fakeStmt{c.driver}, nil }
+func (c *fakeConn) Close() error                          { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)             { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.execArgs = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: args}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.next]
+	r.next++
+
+	return nil
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("maybefake", fake)
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("maybefake", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func scanAll[T any](t *testing.T, db *sql.DB, args ...any) []Option[T] {
+	t.Helper()
+
+	rows, err := db.Query("SELECT", args...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rows.Close()
+
+	var scanned []Option[T]
+	for rows.Next() {
+		var o Option[T]
+		if err := rows.Scan(&o); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		scanned = append(scanned, o)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return scanned
+}
+
+func TestOption_Scan(t *testing.T) {
+	db := openFakeDB(t)
+
+	ints := scanAll[int64](t, db, int64(7), nil)
+	if value, ok := ints[0].Unwrap(); !ok || value != 7 {
+		t.Errorf("expected Some(7), but got %d, %t", value, ok)
+	}
+	if ints[1].IsSome() {
+		t.Error("expected NULL to scan as None")
+	}
+
+	floats := scanAll[float64](t, db, 2.5)
+	if value, ok := floats[0].Unwrap(); !ok || value != 2.5 {
+		t.Errorf("expected Some(2.5), but got %v, %t", value, ok)
+	}
+
+	bools := scanAll[bool](t, db, true)
+	if value, ok := bools[0].Unwrap(); !ok || !value {
+		t.Errorf("expected Some(true), but got %t, %t", value, ok)
+	}
+
+	bytes := scanAll[[]byte](t, db, []byte("raw"))
+	if value, ok := bytes[0].Unwrap(); !ok || string(value) != "raw" {
+		t.Errorf("expected Some(\"raw\"), but got %q, %t", value, ok)
+	}
+
+	strings := scanAll[string](t, db, "text", []byte("bytes"), int64(3))
+	for i, expected := range []string{"text", "bytes", "3"} {
+		if value, ok := strings[i].Unwrap(); !ok || value != expected {
+			t.Errorf("expected Some(%q), but got %q, %t", expected, value, ok)
+		}
+	}
+
+	now := time.Date(2024, 5, 17, 12, 0, 0, 0, time.UTC)
+	times := scanAll[time.Time](t, db, now)
+	if value, ok := times[0].Unwrap(); !ok || !value.Equal(now) {
+		t.Errorf("expected Some(%v), but got %v, %t", now, value, ok)
+	}
+}
+
+func TestOption_ScanError(t *testing.T) {
+	o := OptionOf(Some(5))
+	if err := o.Scan("not a number"); err == nil {
+		t.Error("expected an error when scanning a string into Option[int]")
+	}
+	if value, ok := o.Unwrap(); !ok || value != 5 {
+		t.Errorf("expected failed scan to leave Some(5), but got %d, %t", value, ok)
+	}
+}
+
+func TestMaybe_Scan(t *testing.T) {
+	db := openFakeDB(t)
+
+	m := None[string]()
+	if err := db.QueryRow("SELECT", "test").Scan(m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := m.Unwrap(); !ok || value != "test" {
+		t.Errorf("expected Some(\"test\"), but got %q, %t", value, ok)
+	}
+
+	if err := db.QueryRow("SELECT", nil).Scan(m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.IsSome() {
+		t.Error("expected NULL to scan as None")
+	}
+}
+
+func TestValue(t *testing.T) {
+	db := openFakeDB(t)
+
+	_, err := db.Exec("INSERT",
+		Some(int64(4)), None[string](), OptionOf(Some("test")), Option[float64]{},
+		Some(5), SomeOption(int32(6)), Some(float32(0.5)), NoneOption[int](),
+		SomeOption(sql.NullString{String: "valuer", Valid: true}), SomeOption[*sql.NullString](nil),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []driver.Value{int64(4), nil, "test", nil, int64(5), int64(6), float64(0.5), nil, "valuer", nil}
+	if len(fake.execArgs) != len(expected) {
+		t.Fatalf("expected %d arguments, but got %d", len(expected), len(fake.execArgs))
+	}
+	for i, value := range fake.execArgs {
+		if value != expected[i] {
+			t.Errorf("expected argument %d to be %v, but got %v", i, expected[i], value)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package maybe
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// MarshalText encodes Some as the text of its value and None as empty text.
+// The value must implement encoding.TextMarshaler or have a string, bool or
+// numeric underlying type.
+func (m *maybe[T]) MarshalText() ([]byte, error) {
+	return marshalText(m.value, m.isSome)
+}
+
+// UnmarshalText decodes empty text as None and any other text as Some. Note
+// that this means that Some("") does not survive a round trip.
+func (m *maybe[T]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &m.value, &m.isSome)
+}
+
+// MarshalText encodes Some as the text of its value and None as empty text.
+// The value must implement encoding.TextMarshaler or have a string, bool or
+// numeric underlying type.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	return marshalText(o.value, o.isSome)
+}
+
+// UnmarshalText decodes empty text as None and any other text as Some. Note
+// that this means that Some("") does not survive a round trip.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	return unmarshalText(text, &o.value, &o.isSome)
+}
+
+func marshalText[T any](value T, isSome bool) ([]byte, error) {
+	if !isSome {
+		return []byte{}, nil
+	}
+	text, err := formatText(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(text), nil
+}
+
+func unmarshalText[T any](text []byte, value *T, isSome *bool) error {
+	if len(text) == 0 {
+		*value, *isSome = *new(T), false
+
+		return nil
+	}
+	parsed, err := parseText[T](string(text), 10)
+	if err != nil {
+		return err
+	}
+	*value, *isSome = parsed, true
+
+	return nil
+}
+
+// formatText formats a value as text, the inverse of parseText.
+func formatText[T any](value T) (string, error) {
+	if marshaler, ok := any(value).(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+
+		return string(text), err
+	}
+	if duration, ok := any(value).(time.Duration); ok {
+		return duration.String(), nil
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("maybe: cannot marshal %v as text", v.Type())
+}
+
+// parseText parses text into a value of type T, which must implement
+// encoding.TextUnmarshaler (through a pointer), be a time.Duration, or have a
+// string, bool or numeric underlying type. Integers are parsed in the given
+// base, as by strconv.ParseInt.
+func parseText[T any](text string, base int) (T, error) {
+	var value T
+
+	if unmarshaler, ok := any(&value).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(text))
+
+		return value, err
+	}
+	if duration, ok := any(&value).(*time.Duration); ok {
+		var err error
+		*duration, err = time.ParseDuration(text)
+
+		return value, err
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return value, err
+		}
+		v.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, base, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+		v.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		parsed, err := strconv.ParseUint(text, base, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+		v.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return value, err
+		}
+		v.SetFloat(parsed)
+	default:
+		return value, fmt.Errorf("maybe: cannot unmarshal text into %v", v.Type())
+	}
+
+	return value, nil
+}
@@ -0,0 +1,83 @@
+package maybe
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestOption_TextRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 17, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		value    encoding.TextMarshaler
+		decoded  encoding.TextUnmarshaler
+		expected string
+	}{
+		{SomeOption(42), &Option[int]{}, "42"},
+		{SomeOption(uint8(255)), &Option[uint8]{}, "255"},
+		{SomeOption(-0.25), &Option[float64]{}, "-0.25"},
+		{SomeOption(true), &Option[bool]{}, "true"},
+		{SomeOption("test"), &Option[string]{}, "test"},
+		{SomeOption(90 * time.Second), &Option[time.Duration]{}, "1m30s"},
+		{SomeOption(now), &Option[time.Time]{}, "2024-05-17T12:30:00Z"},
+		{NoneOption[int](), &Option[int]{}, ""},
+	}
+
+	for _, tc := range testCases {
+		text, err := tc.value.MarshalText()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(text) != tc.expected {
+			t.Errorf("expected %v to marshal to %q, but got %q", tc.value, tc.expected, text)
+		}
+
+		if err := tc.decoded.UnmarshalText(text); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fmt.Sprintf("%#v", tc.decoded) != fmt.Sprintf("%#v", tc.value) {
+			t.Errorf("expected %q to unmarshal to %v, but got %v", text, tc.value, tc.decoded)
+		}
+	}
+}
+
+func TestMaybe_TextRoundTrip(t *testing.T) {
+	text, err := Some(42).(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(text) != "42" {
+		t.Errorf("expected \"42\", but got %q", text)
+	}
+
+	m := None[int]()
+	if err := m.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(m, Some(42)) {
+		t.Errorf("expected Some(42), but got %v", m)
+	}
+
+	if err := m.(encoding.TextUnmarshaler).UnmarshalText(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.IsSome() {
+		t.Error("expected empty text to unmarshal to None")
+	}
+}
+
+func TestText_Errors(t *testing.T) {
+	var number Option[int8]
+	if err := number.UnmarshalText([]byte("300")); err == nil {
+		t.Error("expected an error when unmarshalling an out of range int8")
+	}
+	if number.IsSome() {
+		t.Error("expected failed unmarshal to leave None")
+	}
+
+	if _, err := SomeOption([]int{1}).MarshalText(); err == nil {
+		t.Error("expected an error when marshalling a slice as text")
+	}
+}
@@ -0,0 +1,90 @@
+package maybe
+
+import "encoding/xml"
+
+// MarshalXML encodes Some as an element holding its value, and leaves the
+// element out for None.
+func (m *maybe[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, m.value, m.isSome)
+}
+
+// UnmarshalXML decodes an element as Some. An element that is missing from
+// the input is left untouched.
+func (m *maybe[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &m.value, &m.isSome)
+}
+
+// MarshalXMLAttr encodes Some as an attribute holding the text of its value,
+// and leaves the attribute out for None.
+func (m *maybe[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, m.value, m.isSome)
+}
+
+// UnmarshalXMLAttr decodes an attribute as Some.
+func (m *maybe[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &m.value, &m.isSome)
+}
+
+// MarshalXML encodes Some as an element holding its value, and leaves the
+// element out for None.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, o.value, o.isSome)
+}
+
+// UnmarshalXML decodes an element as Some. An element that is missing from
+// the input is left untouched, i.e. None for a zero Option.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, &o.value, &o.isSome)
+}
+
+// MarshalXMLAttr encodes Some as an attribute holding the text of its value,
+// and leaves the attribute out for None.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, o.value, o.isSome)
+}
+
+// UnmarshalXMLAttr decodes an attribute as Some. An attribute that is missing
+// from the input is left untouched, i.e. None for a zero Option.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, &o.value, &o.isSome)
+}
+
+func marshalXML[T any](e *xml.Encoder, start xml.StartElement, value T, isSome bool) error {
+	if !isSome {
+		return nil
+	}
+
+	return e.EncodeElement(value, start)
+}
+
+func unmarshalXML[T any](d *xml.Decoder, start xml.StartElement, value *T, isSome *bool) error {
+	var decoded T
+	if err := d.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+	*value, *isSome = decoded, true
+
+	return nil
+}
+
+func marshalXMLAttr[T any](name xml.Name, value T, isSome bool) (xml.Attr, error) {
+	if !isSome {
+		return xml.Attr{}, nil
+	}
+	text, err := formatText(value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+func unmarshalXMLAttr[T any](attr xml.Attr, value *T, isSome *bool) error {
+	parsed, err := parseText[T](attr.Value, 10)
+	if err != nil {
+		return err
+	}
+	*value, *isSome = parsed, true
+
+	return nil
+}
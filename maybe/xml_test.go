@@ -0,0 +1,96 @@
+package maybe
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlTestStruct struct {
+	XMLName xml.Name       `xml:"person"`
+	ID      Option[int]    `xml:"id,attr"`
+	Name    Option[string] `xml:"name"`
+	Age     Option[int]    `xml:"age"`
+}
+
+func TestOption_MarshalXML(t *testing.T) {
+	testCases := []struct {
+		value    xmlTestStruct
+		expected string
+	}{
+		{
+			xmlTestStruct{ID: SomeOption(7), Name: SomeOption("Alice"), Age: SomeOption(0)},
+			`<person id="7"><name>Alice</name><age>0</age></person>`,
+		},
+		{
+			xmlTestStruct{},
+			`<person></person>`,
+		},
+	}
+
+	for _, tc := range testCases {
+		data, err := xml.Marshal(tc.value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("expected %s, but got %s", tc.expected, data)
+		}
+	}
+}
+
+func TestOption_UnmarshalXML(t *testing.T) {
+	var decoded xmlTestStruct
+	if err := xml.Unmarshal([]byte(`<person id="7"><name>Bob</name></person>`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !Equal[int](decoded.ID, Some(7)) {
+		t.Errorf("expected id to be Some(7), but got %v", decoded.ID)
+	}
+	if !Equal[string](decoded.Name, Some("Bob")) {
+		t.Errorf("expected name to be Some(\"Bob\"), but got %v", decoded.Name)
+	}
+	if decoded.Age.IsSome() {
+		t.Error("expected missing age to be None")
+	}
+}
+
+func TestOption_XMLRoundTrip(t *testing.T) {
+	original := xmlTestStruct{ID: SomeOption(1), Age: SomeOption(31)}
+	data, err := xml.Marshal(original)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded xmlTestStruct
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.ID != original.ID || decoded.Name != original.Name || decoded.Age != original.Age {
+		t.Errorf("expected %v, but got %v", original, decoded)
+	}
+}
+
+func TestMaybe_XMLRoundTrip(t *testing.T) {
+	type wrapper struct {
+		XMLName xml.Name      `xml:"wrapper"`
+		Value   Maybe[string] `xml:"value"`
+		Other   Maybe[string] `xml:"other"`
+	}
+
+	data, err := xml.Marshal(wrapper{Value: Some("test"), Other: None[string]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `<wrapper><value>test</value></wrapper>`; string(data) != expected {
+		t.Errorf("expected %s, but got %s", expected, data)
+	}
+
+	m := None[string]()
+	if err := xml.Unmarshal([]byte(`<value>test</value>`), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(m, Some("test")) {
+		t.Errorf("expected Some(\"test\"), but got %v", m)
+	}
+}
@@ -0,0 +1,122 @@
+package result
+
+import (
+	"errors"
+
+	"github.com/sjpeterson/typical/either"
+)
+
+// ErrNilLeft is the error of a Result converted from a Left holding a nil error.
+var ErrNilLeft = errors.New("result: Left with nil error")
+
+// Result[T] is either a value of type T or an error, like the return values
+// of a fallible function.
+type Result[T any] interface {
+	IsOk() bool
+	IsErr() bool
+	Unwrap() (T, error)
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates a Result with a value.
+func Ok[T any](value T) Result[T] {
+	return &result[T]{value: value}
+}
+
+// Err creates a Result with an error. A nil error gives Ok with the zero value.
+func Err[T any](err error) Result[T] {
+	return &result[T]{err: err}
+}
+
+// Of creates a Result from the return values of a fallible function. The
+// value is only kept if the error is nil.
+func Of[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok(value)
+}
+
+// Try calls a fallible function and returns its result.
+func Try[T any](f func() (T, error)) Result[T] {
+	return Of(f())
+}
+
+// IsOk returns true if the Result has a value.
+func (r *result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result has an error.
+func (r *result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns the value and error of the Result, as returned by a fallible
+// function. The error is returned as is, so it can be inspected with
+// errors.Is and errors.As.
+func (r *result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// Map applies a function to the value of a Result, if it is Ok. An error is
+// passed on without calling the function.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return Err[U](err)
+	}
+
+	return Ok(f(value))
+}
+
+// AndThen applies a fallible function to the value of a Result, if it is Ok.
+// An error is passed on without calling the function.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return Err[U](err)
+	}
+
+	return f(value)
+}
+
+// OrElse calls a function with the error of a Result, if it is Err, to recover
+// from it or to replace the error. An Ok is returned unchanged.
+func OrElse[T any](r Result[T], f func(error) Result[T]) Result[T] {
+	if _, err := r.Unwrap(); err != nil {
+		return f(err)
+	}
+
+	return r
+}
+
+// FromEither converts an Either with an error on the left to a Result. A Left
+// always gives Err, with ErrNilLeft in place of a nil error.
+func FromEither[T any](e either.Either[error, T]) Result[T] {
+	if err, ok := e.UnwrapLeft(); ok {
+		if err == nil {
+			err = ErrNilLeft
+		}
+
+		return Err[T](err)
+	}
+	value, _ := e.UnwrapRight()
+
+	return Ok(value)
+}
+
+// ToEither converts a Result to an Either with the error on the left.
+func ToEither[T any](r Result[T]) either.Either[error, T] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return either.Left[error, T](err)
+	}
+
+	return either.Right[error](value)
+}
@@ -0,0 +1,192 @@
+package result
+
+import (
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	"github.com/sjpeterson/typical/either"
+)
+
+var errTest = errors.New("test error")
+
+func TestOk(t *testing.T) {
+	r := Ok(6)
+
+	if !r.IsOk() {
+		t.Error("Ok(6).IsOk() is false")
+	}
+	if r.IsErr() {
+		t.Error("Ok(6).IsErr() is true")
+	}
+	if value, err := r.Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected unwrapping Ok(6) to give 6, nil, but got %d, %v", value, err)
+	}
+}
+
+func TestErr(t *testing.T) {
+	r := Err[int](errTest)
+
+	if r.IsOk() {
+		t.Error("Err[int](errTest).IsOk() is true")
+	}
+	if !r.IsErr() {
+		t.Error("Err[int](errTest).IsErr() is false")
+	}
+	if _, err := r.Unwrap(); err != errTest {
+		t.Errorf("expected unwrapping Err[int](errTest) to give errTest, but got %v", err)
+	}
+
+	if !Err[int](nil).IsOk() {
+		t.Error("Err[int](nil).IsOk() is false")
+	}
+}
+
+func TestOf(t *testing.T) {
+	if value, err := Of(strconv.Atoi("6")).Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected 6, nil, but got %d, %v", value, err)
+	}
+
+	r := Of(strconv.Atoi("six"))
+	if !r.IsErr() {
+		t.Fatal("expected Of with an error to be Err")
+	}
+	if _, err := r.Unwrap(); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error, but got %v", err)
+	}
+}
+
+func TestTry(t *testing.T) {
+	if value, err := Try(func() (int, error) { return 6, nil }).Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected 6, nil, but got %d, %v", value, err)
+	}
+
+	if _, err := Try(func() (int, error) { return 0, errTest }).Unwrap(); err != errTest {
+		t.Errorf("expected errTest, but got %v", err)
+	}
+}
+
+func TestMap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+
+	if value, err := Map(Ok(21), double).Unwrap(); err != nil || value != 42 {
+		t.Errorf("expected 42, nil, but got %d, %v", value, err)
+	}
+
+	called := false
+	mapped := Map(Err[int](errTest), func(n int) int {
+		called = true
+
+		return n
+	})
+	if _, err := mapped.Unwrap(); err != errTest {
+		t.Errorf("expected errTest, but got %v", err)
+	}
+	if called {
+		t.Error("Map called the function for Err")
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	parse := func(s string) Result[int] { return Of(strconv.Atoi(s)) }
+
+	if value, err := AndThen(Ok("6"), parse).Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected 6, nil, but got %d, %v", value, err)
+	}
+
+	if _, err := AndThen(Ok("six"), parse).Unwrap(); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected a syntax error, but got %v", err)
+	}
+
+	called := false
+	chained := AndThen(Err[string](errTest), func(s string) Result[int] {
+		called = true
+
+		return parse(s)
+	})
+	if _, err := chained.Unwrap(); err != errTest {
+		t.Errorf("expected errTest, but got %v", err)
+	}
+	if called {
+		t.Error("AndThen called the function for Err")
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	fallback := func(err error) Result[int] {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Ok(0)
+		}
+
+		return Err[int](err)
+	}
+
+	if value, err := OrElse(Err[int](fs.ErrNotExist), fallback).Unwrap(); err != nil || value != 0 {
+		t.Errorf("expected 0, nil, but got %d, %v", value, err)
+	}
+
+	if _, err := OrElse(Err[int](errTest), fallback).Unwrap(); err != errTest {
+		t.Errorf("expected errTest, but got %v", err)
+	}
+
+	called := false
+	recovered := OrElse(Ok(6), func(err error) Result[int] {
+		called = true
+
+		return fallback(err)
+	})
+	if value, err := recovered.Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected 6, nil, but got %d, %v", value, err)
+	}
+	if called {
+		t.Error("OrElse called the function for Ok")
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	_, err := Of(strconv.Atoi("six")).Unwrap()
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Fatalf("expected a *strconv.NumError, but got %T", err)
+	}
+	if numErr.Num != "six" {
+		t.Errorf("expected the error to be about \"six\", but got %q", numErr.Num)
+	}
+}
+
+func TestFromEither(t *testing.T) {
+	if value, err := FromEither(either.Right[error](6)).Unwrap(); err != nil || value != 6 {
+		t.Errorf("expected 6, nil, but got %d, %v", value, err)
+	}
+	if _, err := FromEither(either.Left[error, int](errTest)).Unwrap(); err != errTest {
+		t.Errorf("expected errTest, but got %v", err)
+	}
+
+	nilLeft := FromEither(either.Left[error, int](nil))
+	if !nilLeft.IsErr() {
+		t.Fatal("expected FromEither of Left(nil) to be Err")
+	}
+	if _, err := nilLeft.Unwrap(); !errors.Is(err, ErrNilLeft) {
+		t.Errorf("expected ErrNilLeft, but got %v", err)
+	}
+}
+
+func TestToEither(t *testing.T) {
+	if value, ok := ToEither(Ok(6)).UnwrapRight(); !ok || value != 6 {
+		t.Errorf("expected Right(6), but got %d, %t", value, ok)
+	}
+	if err, ok := ToEither(Err[int](errTest)).UnwrapLeft(); !ok || err != errTest {
+		t.Errorf("expected Left(errTest), but got %v, %t", err, ok)
+	}
+}
+
+func TestTry_DoesNotRecoverPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected the panic in the function to propagate")
+		}
+	}()
+	Try(func() (int, error) { panic("oops") })
+}